@@ -10,6 +10,12 @@ var (
 	// passed.
 	ErrCheckpointRequired = errors.New("checkpoint required")
 
+	// ErrDocumentKeyRequired is returned when an empty document key is passed.
+	ErrDocumentKeyRequired = errors.New("document key required")
+
+	// ErrCollectionRequired is returned when an empty collection is passed.
+	ErrCollectionRequired = errors.New("collection required")
+
 	// ErrUnsupportedOperation is returned when the given operation is not
 	// supported yet.
 	ErrUnsupportedOperation = errors.New("unsupported operation")
@@ -29,4 +35,12 @@ var (
 	// ErrUnsupportedCounterType is returned when the given counter type is not
 	// supported yet.
 	ErrUnsupportedCounterType = errors.New("unsupported counter type")
+
+	// ErrUnsupportedSnapshotVersion is returned when the version of the given
+	// snapshot is newer than the version this package can read.
+	ErrUnsupportedSnapshotVersion = errors.New("unsupported snapshot version")
+
+	// ErrUnsupportedSnapshotCompression is returned when the given snapshot
+	// compression is not supported.
+	ErrUnsupportedSnapshotCompression = errors.New("unsupported snapshot compression")
 )
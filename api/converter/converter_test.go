@@ -18,6 +18,7 @@ package converter_test
 
 import (
 	"math"
+	"strings"
 	"testing"
 	gotime "time"
 
@@ -61,6 +62,55 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, `{"k1":"B"}`, obj.Marshal())
 	})
 
+	t.Run("snapshot envelope test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, strings.Repeat("A", 1000))
+			return nil
+		}))
+
+		raw, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		assert.False(t, converter.IsSnapshotEnvelope(raw))
+
+		for _, compression := range []api.SnapshotCompression{
+			api.SnapshotCompression_NO_COMPRESSION,
+			api.SnapshotCompression_GZIP,
+			api.SnapshotCompression_ZSTD,
+		} {
+			snapshot, err := converter.ObjectToSnapshot(doc.RootObject(), compression)
+			assert.NoError(t, err)
+			assert.True(t, converter.IsSnapshotEnvelope(snapshot))
+			assert.Equal(t, compression, converter.SnapshotCompressionOf(snapshot))
+			if compression != api.SnapshotCompression_NO_COMPRESSION {
+				assert.Less(t, len(snapshot), len(raw))
+			}
+
+			decoded, err := converter.DecodeSnapshot(snapshot)
+			assert.NoError(t, err)
+			assert.Equal(t, raw, decoded)
+
+			obj, err := converter.BytesToObject(snapshot)
+			assert.NoError(t, err)
+			assert.Equal(t, doc.Marshal(), obj.Marshal())
+		}
+
+		// the legacy format is decoded as it is.
+		decoded, err := converter.DecodeSnapshot(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, decoded)
+
+		// unknown versions and compressions are not decoded.
+		snapshot, err := converter.EncodeSnapshot(raw, api.SnapshotCompression_NO_COMPRESSION)
+		assert.NoError(t, err)
+		snapshot[4] = converter.SnapshotVersion + 1
+		_, err = converter.DecodeSnapshot(snapshot)
+		assert.ErrorIs(t, err, converter.ErrUnsupportedSnapshotVersion)
+
+		_, err = converter.EncodeSnapshot(raw, api.SnapshotCompression(100))
+		assert.ErrorIs(t, err, converter.ErrUnsupportedSnapshotCompression)
+	})
+
 	t.Run("snapshot test", func(t *testing.T) {
 		doc := document.New("c1", "d1")
 
@@ -27,14 +27,20 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
-// BytesToObject creates an Object from the given byte array.
+// BytesToObject creates an Object from the given byte array. The byte array
+// can be either a snapshot in the envelope or the raw protobuf bytes.
 func BytesToObject(snapshot []byte) (*json.Object, error) {
 	if snapshot == nil {
 		return json.NewObject(json.NewRHTPriorityQueueMap(), time.InitialTicket), nil
 	}
 
+	raw, err := DecodeSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
 	pbElem := &api.JSONElement{}
-	if err := proto.Unmarshal(snapshot, pbElem); err != nil {
+	if err := proto.Unmarshal(raw, pbElem); err != nil {
 		return nil, err
 	}
 
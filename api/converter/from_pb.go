@@ -79,6 +79,15 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 	}, nil
 }
 
+// FromDocumentKey converts the given Protobuf format to model format.
+func FromDocumentKey(pbKey *api.DocumentKey) (*key.Key, error) {
+	if pbKey == nil {
+		return nil, ErrDocumentKeyRequired
+	}
+
+	return fromDocumentKey(pbKey), nil
+}
+
 func fromDocumentKey(pbKey *api.DocumentKey) *key.Key {
 	return &key.Key{
 		Collection: pbKey.Collection,
@@ -93,22 +102,32 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) *checkpoint.Checkpoint {
 	)
 }
 
+// FromChange converts the given Protobuf format to model format.
+func FromChange(pbChange *api.Change) (*change.Change, error) {
+	changeID, err := fromChangeID(pbChange.Id)
+	if err != nil {
+		return nil, err
+	}
+	operations, err := FromOperations(pbChange.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	return change.New(
+		changeID,
+		pbChange.Message,
+		operations,
+	), nil
+}
+
 func fromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	var changes []*change.Change
 	for _, pbChange := range pbChanges {
-		changeID, err := fromChangeID(pbChange.Id)
-		if err != nil {
-			return nil, err
-		}
-		operations, err := FromOperations(pbChange.Operations)
+		c, err := FromChange(pbChange)
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, change.New(
-			changeID,
-			pbChange.Message,
-			operations,
-		))
+		changes = append(changes, c)
 	}
 
 	return changes, nil
@@ -146,6 +165,8 @@ func FromEventType(pbDocEventType api.DocEventType) (types.DocEventType, error)
 		return types.DocumentsUnwatchedEvent, nil
 	case api.DocEventType_METADATA_CHANGED:
 		return types.MetadataChangedEvent, nil
+	case api.DocEventType_BROADCAST:
+		return types.BroadcastEvent, nil
 	}
 	return "", fmt.Errorf("%v: %w", pbDocEventType, ErrUnsupportedEventType)
 }
@@ -162,10 +183,19 @@ func FromDocEvent(docEvent *api.DocEvent) (*sync.DocEvent, error) {
 		return nil, err
 	}
 
+	var pack *change.Pack
+	if docEvent.ChangePack != nil {
+		if pack, err = FromChangePack(docEvent.ChangePack); err != nil {
+			return nil, err
+		}
+	}
+
 	return &sync.DocEvent{
 		Type:         eventType,
 		Publisher:    *client,
 		DocumentKeys: FromDocumentKeys(docEvent.DocumentKeys),
+		Payload:      docEvent.Payload,
+		ChangePack:   pack,
 	}, nil
 }
 
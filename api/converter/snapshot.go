@@ -0,0 +1,159 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// SnapshotVersion is the version of the snapshot envelope written by this
+// package.
+const SnapshotVersion byte = 1
+
+// snapshotMagic is the prefix of the snapshot envelope. The envelope is
+// snapshotMagic, the version, the compression and the compressed payload in
+// order. A snapshot without the prefix is in the legacy format, the raw
+// protobuf bytes of the root object.
+var snapshotMagic = []byte("YSNP")
+
+// snapshotHeaderLen is the length of the header of the snapshot envelope.
+var snapshotHeaderLen = len(snapshotMagic) + 2
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// ObjectToSnapshot converts the given object to a snapshot in the envelope
+// compressed with the given compression.
+func ObjectToSnapshot(obj *json.Object, compression api.SnapshotCompression) ([]byte, error) {
+	raw, err := ObjectToBytes(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeSnapshot(raw, compression)
+}
+
+// EncodeSnapshot wraps the given raw snapshot in the envelope compressed with
+// the given compression.
+func EncodeSnapshot(raw []byte, compression api.SnapshotCompression) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, snapshotHeaderLen+len(raw)))
+	buf.Write(snapshotMagic)
+	buf.WriteByte(SnapshotVersion)
+	buf.WriteByte(byte(compression))
+
+	switch compression {
+	case api.SnapshotCompression_NO_COMPRESSION:
+		buf.Write(raw)
+	case api.SnapshotCompression_GZIP:
+		writer := gzip.NewWriter(buf)
+		if _, err := writer.Write(raw); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+	case api.SnapshotCompression_ZSTD:
+		encoder, _, err := zstdCodec()
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(raw, buf.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("%d: %w", compression, ErrUnsupportedSnapshotCompression)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecodeSnapshot returns the raw snapshot of the given snapshot. The snapshot
+// can be either in the envelope or in the legacy format.
+func DecodeSnapshot(snapshot []byte) ([]byte, error) {
+	if !IsSnapshotEnvelope(snapshot) {
+		return snapshot, nil
+	}
+
+	version := snapshot[len(snapshotMagic)]
+	if version > SnapshotVersion {
+		return nil, fmt.Errorf("%d: %w", version, ErrUnsupportedSnapshotVersion)
+	}
+
+	compression := api.SnapshotCompression(snapshot[len(snapshotMagic)+1])
+	payload := snapshot[snapshotHeaderLen:]
+
+	switch compression {
+	case api.SnapshotCompression_NO_COMPRESSION:
+		return payload, nil
+	case api.SnapshotCompression_GZIP:
+		reader, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = reader.Close()
+		}()
+		return ioutil.ReadAll(reader)
+	case api.SnapshotCompression_ZSTD:
+		_, decoder, err := zstdCodec()
+		if err != nil {
+			return nil, err
+		}
+		return decoder.DecodeAll(payload, nil)
+	default:
+		return nil, fmt.Errorf("%d: %w", compression, ErrUnsupportedSnapshotCompression)
+	}
+}
+
+// IsSnapshotEnvelope returns whether the given snapshot is in the envelope.
+func IsSnapshotEnvelope(snapshot []byte) bool {
+	return len(snapshot) >= snapshotHeaderLen && bytes.HasPrefix(snapshot, snapshotMagic)
+}
+
+// SnapshotCompressionOf returns the compression of the given snapshot. The
+// snapshot in the legacy format is not compressed.
+func SnapshotCompressionOf(snapshot []byte) api.SnapshotCompression {
+	if !IsSnapshotEnvelope(snapshot) {
+		return api.SnapshotCompression_NO_COMPRESSION
+	}
+
+	return api.SnapshotCompression(snapshot[len(snapshotMagic)+1])
+}
+
+// zstdCodec returns the zstd encoder and decoder shared by this package. Both
+// of them are safe for concurrent use with EncodeAll and DecodeAll.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdOnce.Do(func() {
+		if zstdEncoder, zstdErr = zstd.NewWriter(nil); zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+
+	return zstdEncoder, zstdDecoder, zstdErr
+}
@@ -126,6 +126,8 @@ func ToDocEventType(eventType types.DocEventType) (api.DocEventType, error) {
 		return api.DocEventType_DOCUMENTS_UNWATCHED, nil
 	case types.MetadataChangedEvent:
 		return api.DocEventType_METADATA_CHANGED, nil
+	case types.BroadcastEvent:
+		return api.DocEventType_BROADCAST, nil
 	default:
 		return 0, fmt.Errorf("%s: %w", eventType, ErrUnsupportedEventType)
 	}
@@ -138,10 +140,19 @@ func ToDocEvent(docEvent sync.DocEvent) (*api.DocEvent, error) {
 		return nil, err
 	}
 
+	var pbChangePack *api.ChangePack
+	if docEvent.ChangePack != nil {
+		if pbChangePack, err = ToChangePack(docEvent.ChangePack); err != nil {
+			return nil, err
+		}
+	}
+
 	return &api.DocEvent{
 		Type:         eventType,
 		Publisher:    ToClient(docEvent.Publisher),
 		DocumentKeys: ToDocumentKeys(docEvent.DocumentKeys),
+		Payload:      docEvent.Payload,
+		ChangePack:   pbChangePack,
 	}, nil
 }
 
@@ -196,20 +207,30 @@ func ToTimeTicket(ticket *time.Ticket) *api.TimeTicket {
 	}
 }
 
+// ToChange converts the given model to Protobuf format.
+func ToChange(c *change.Change) (*api.Change, error) {
+	pbOperations, err := ToOperations(c.Operations())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Change{
+		Id:         ToChangeID(c.ID()),
+		Message:    c.Message(),
+		Operations: pbOperations,
+	}, nil
+}
+
 func toChanges(changes []*change.Change) ([]*api.Change, error) {
 	var pbChanges []*api.Change
 
 	for _, c := range changes {
-		pbOperations, err := ToOperations(c.Operations())
+		pbChange, err := ToChange(c)
 		if err != nil {
 			return nil, err
 		}
 
-		pbChanges = append(pbChanges, &api.Change{
-			Id:         ToChangeID(c.ID()),
-			Message:    c.Message(),
-			Operations: pbOperations,
-		})
+		pbChanges = append(pbChanges, pbChange)
 	}
 
 	return pbChanges, nil
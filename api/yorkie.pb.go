@@ -90,6 +90,34 @@ func (ValueType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{0}
 }
 
+type SnapshotCompression int32
+
+const (
+	SnapshotCompression_NO_COMPRESSION SnapshotCompression = 0
+	SnapshotCompression_GZIP           SnapshotCompression = 1
+	SnapshotCompression_ZSTD           SnapshotCompression = 2
+)
+
+var SnapshotCompression_name = map[int32]string{
+	0: "NO_COMPRESSION",
+	1: "GZIP",
+	2: "ZSTD",
+}
+
+var SnapshotCompression_value = map[string]int32{
+	"NO_COMPRESSION": 0,
+	"GZIP":           1,
+	"ZSTD":           2,
+}
+
+func (x SnapshotCompression) String() string {
+	return proto.EnumName(SnapshotCompression_name, int32(x))
+}
+
+func (SnapshotCompression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{1}
+}
+
 type DocEventType int32
 
 const (
@@ -97,6 +125,7 @@ const (
 	DocEventType_DOCUMENTS_WATCHED   DocEventType = 1
 	DocEventType_DOCUMENTS_UNWATCHED DocEventType = 2
 	DocEventType_METADATA_CHANGED    DocEventType = 3
+	DocEventType_BROADCAST           DocEventType = 4
 )
 
 var DocEventType_name = map[int32]string{
@@ -104,6 +133,7 @@ var DocEventType_name = map[int32]string{
 	1: "DOCUMENTS_WATCHED",
 	2: "DOCUMENTS_UNWATCHED",
 	3: "METADATA_CHANGED",
+	4: "BROADCAST",
 }
 
 var DocEventType_value = map[string]int32{
@@ -111,6 +141,7 @@ var DocEventType_value = map[string]int32{
 	"DOCUMENTS_WATCHED":   1,
 	"DOCUMENTS_UNWATCHED": 2,
 	"METADATA_CHANGED":    3,
+	"BROADCAST":           4,
 }
 
 func (x DocEventType) String() string {
@@ -118,7 +149,7 @@ func (x DocEventType) String() string {
 }
 
 func (DocEventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{1}
+	return fileDescriptor_9df40050e88fbc16, []int{2}
 }
 
 type BroadcastEventRequest struct {
@@ -215,6 +246,116 @@ func (m *BroadcastEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BroadcastEventResponse proto.InternalMessageInfo
 
+type PurgeAuthCacheRequest struct {
+	Token                string       `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Subject              string       `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	DocumentKey          *DocumentKey `protobuf:"bytes,3,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PurgeAuthCacheRequest) Reset()         { *m = PurgeAuthCacheRequest{} }
+func (m *PurgeAuthCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeAuthCacheRequest) ProtoMessage()    {}
+func (*PurgeAuthCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{2}
+}
+func (m *PurgeAuthCacheRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeAuthCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeAuthCacheRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeAuthCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeAuthCacheRequest.Merge(m, src)
+}
+func (m *PurgeAuthCacheRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeAuthCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeAuthCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeAuthCacheRequest proto.InternalMessageInfo
+
+func (m *PurgeAuthCacheRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *PurgeAuthCacheRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *PurgeAuthCacheRequest) GetDocumentKey() *DocumentKey {
+	if m != nil {
+		return m.DocumentKey
+	}
+	return nil
+}
+
+type PurgeAuthCacheResponse struct {
+	PurgedCount          int32    `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeAuthCacheResponse) Reset()         { *m = PurgeAuthCacheResponse{} }
+func (m *PurgeAuthCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeAuthCacheResponse) ProtoMessage()    {}
+func (*PurgeAuthCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{3}
+}
+func (m *PurgeAuthCacheResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeAuthCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeAuthCacheResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeAuthCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeAuthCacheResponse.Merge(m, src)
+}
+func (m *PurgeAuthCacheResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeAuthCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeAuthCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeAuthCacheResponse proto.InternalMessageInfo
+
+func (m *PurgeAuthCacheResponse) GetPurgedCount() int32 {
+	if m != nil {
+		return m.PurgedCount
+	}
+	return 0
+}
+
 type ActivateClientRequest struct {
 	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -226,7 +367,7 @@ func (m *ActivateClientRequest) Reset()         { *m = ActivateClientRequest{} }
 func (m *ActivateClientRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateClientRequest) ProtoMessage()    {}
 func (*ActivateClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{2}
+	return fileDescriptor_9df40050e88fbc16, []int{4}
 }
 func (m *ActivateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +415,7 @@ func (m *ActivateClientResponse) Reset()         { *m = ActivateClientResponse{}
 func (m *ActivateClientResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateClientResponse) ProtoMessage()    {}
 func (*ActivateClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{3}
+	return fileDescriptor_9df40050e88fbc16, []int{5}
 }
 func (m *ActivateClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +469,7 @@ func (m *DeactivateClientRequest) Reset()         { *m = DeactivateClientRequest
 func (m *DeactivateClientRequest) String() string { return proto.CompactTextString(m) }
 func (*DeactivateClientRequest) ProtoMessage()    {}
 func (*DeactivateClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{4}
+	return fileDescriptor_9df40050e88fbc16, []int{6}
 }
 func (m *DeactivateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +516,7 @@ func (m *DeactivateClientResponse) Reset()         { *m = DeactivateClientRespon
 func (m *DeactivateClientResponse) String() string { return proto.CompactTextString(m) }
 func (*DeactivateClientResponse) ProtoMessage()    {}
 func (*DeactivateClientResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{5}
+	return fileDescriptor_9df40050e88fbc16, []int{7}
 }
 func (m *DeactivateClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,18 +553,19 @@ func (m *DeactivateClientResponse) GetClientId() []byte {
 }
 
 type AttachDocumentRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId             []byte                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack           `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	SnapshotCompressions []SnapshotCompression `protobuf:"varint,3,rep,packed,name=snapshot_compressions,json=snapshotCompressions,proto3,enum=api.SnapshotCompression" json:"snapshot_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *AttachDocumentRequest) Reset()         { *m = AttachDocumentRequest{} }
 func (m *AttachDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*AttachDocumentRequest) ProtoMessage()    {}
 func (*AttachDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{6}
+	return fileDescriptor_9df40050e88fbc16, []int{8}
 }
 func (m *AttachDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +608,13 @@ func (m *AttachDocumentRequest) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *AttachDocumentRequest) GetSnapshotCompressions() []SnapshotCompression {
+	if m != nil {
+		return m.SnapshotCompressions
+	}
+	return nil
+}
+
 type AttachDocumentResponse struct {
 	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
@@ -478,7 +627,7 @@ func (m *AttachDocumentResponse) Reset()         { *m = AttachDocumentResponse{}
 func (m *AttachDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*AttachDocumentResponse) ProtoMessage()    {}
 func (*AttachDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{7}
+	return fileDescriptor_9df40050e88fbc16, []int{9}
 }
 func (m *AttachDocumentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,18 +671,19 @@ func (m *AttachDocumentResponse) GetChangePack() *ChangePack {
 }
 
 type DetachDocumentRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId             []byte                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack           `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	SnapshotCompressions []SnapshotCompression `protobuf:"varint,3,rep,packed,name=snapshot_compressions,json=snapshotCompressions,proto3,enum=api.SnapshotCompression" json:"snapshot_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DetachDocumentRequest) Reset()         { *m = DetachDocumentRequest{} }
 func (m *DetachDocumentRequest) String() string { return proto.CompactTextString(m) }
 func (*DetachDocumentRequest) ProtoMessage()    {}
 func (*DetachDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{8}
+	return fileDescriptor_9df40050e88fbc16, []int{10}
 }
 func (m *DetachDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,6 +726,13 @@ func (m *DetachDocumentRequest) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *DetachDocumentRequest) GetSnapshotCompressions() []SnapshotCompression {
+	if m != nil {
+		return m.SnapshotCompressions
+	}
+	return nil
+}
+
 type DetachDocumentResponse struct {
 	ClientKey            string      `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
 	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
@@ -588,7 +745,7 @@ func (m *DetachDocumentResponse) Reset()         { *m = DetachDocumentResponse{}
 func (m *DetachDocumentResponse) String() string { return proto.CompactTextString(m) }
 func (*DetachDocumentResponse) ProtoMessage()    {}
 func (*DetachDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{9}
+	return fileDescriptor_9df40050e88fbc16, []int{11}
 }
 func (m *DetachDocumentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,6 +791,7 @@ func (m *DetachDocumentResponse) GetChangePack() *ChangePack {
 type WatchDocumentsRequest struct {
 	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
 	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	IncludeChanges       bool           `protobuf:"varint,3,opt,name=include_changes,json=includeChanges,proto3" json:"include_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -643,7 +801,7 @@ func (m *WatchDocumentsRequest) Reset()         { *m = WatchDocumentsRequest{} }
 func (m *WatchDocumentsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDocumentsRequest) ProtoMessage()    {}
 func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{10}
+	return fileDescriptor_9df40050e88fbc16, []int{12}
 }
 func (m *WatchDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,6 +844,13 @@ func (m *WatchDocumentsRequest) GetDocumentKeys() []*DocumentKey {
 	return nil
 }
 
+func (m *WatchDocumentsRequest) GetIncludeChanges() bool {
+	if m != nil {
+		return m.IncludeChanges
+	}
+	return false
+}
+
 type WatchDocumentsResponse struct {
 	// Types that are valid to be assigned to Body:
 	//	*WatchDocumentsResponse_Initialization_
@@ -700,7 +865,7 @@ func (m *WatchDocumentsResponse) Reset()         { *m = WatchDocumentsResponse{}
 func (m *WatchDocumentsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchDocumentsResponse) ProtoMessage()    {}
 func (*WatchDocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{11}
+	return fileDescriptor_9df40050e88fbc16, []int{13}
 }
 func (m *WatchDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +950,7 @@ func (m *WatchDocumentsResponse_Initialization) Reset()         { *m = WatchDocu
 func (m *WatchDocumentsResponse_Initialization) String() string { return proto.CompactTextString(m) }
 func (*WatchDocumentsResponse_Initialization) ProtoMessage()    {}
 func (*WatchDocumentsResponse_Initialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{11, 0}
+	return fileDescriptor_9df40050e88fbc16, []int{13, 0}
 }
 func (m *WatchDocumentsResponse_Initialization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,19 +986,92 @@ func (m *WatchDocumentsResponse_Initialization) GetPeersMapByDoc() map[string]*C
 	return nil
 }
 
+type WatchDocumentsStreamRequest struct {
+	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	AddedDocumentKeys    []*DocumentKey `protobuf:"bytes,2,rep,name=added_document_keys,json=addedDocumentKeys,proto3" json:"added_document_keys,omitempty"`
+	RemovedDocumentKeys  []*DocumentKey `protobuf:"bytes,3,rep,name=removed_document_keys,json=removedDocumentKeys,proto3" json:"removed_document_keys,omitempty"`
+	IncludeChanges       bool           `protobuf:"varint,4,opt,name=include_changes,json=includeChanges,proto3" json:"include_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WatchDocumentsStreamRequest) Reset()         { *m = WatchDocumentsStreamRequest{} }
+func (m *WatchDocumentsStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsStreamRequest) ProtoMessage()    {}
+func (*WatchDocumentsStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{14}
+}
+func (m *WatchDocumentsStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchDocumentsStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchDocumentsStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchDocumentsStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsStreamRequest.Merge(m, src)
+}
+func (m *WatchDocumentsStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchDocumentsStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchDocumentsStreamRequest proto.InternalMessageInfo
+
+func (m *WatchDocumentsStreamRequest) GetClient() *Client {
+	if m != nil {
+		return m.Client
+	}
+	return nil
+}
+
+func (m *WatchDocumentsStreamRequest) GetAddedDocumentKeys() []*DocumentKey {
+	if m != nil {
+		return m.AddedDocumentKeys
+	}
+	return nil
+}
+
+func (m *WatchDocumentsStreamRequest) GetRemovedDocumentKeys() []*DocumentKey {
+	if m != nil {
+		return m.RemovedDocumentKeys
+	}
+	return nil
+}
+
+func (m *WatchDocumentsStreamRequest) GetIncludeChanges() bool {
+	if m != nil {
+		return m.IncludeChanges
+	}
+	return false
+}
+
 type PushPullRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ClientId             []byte                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack           `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	PushOnly             bool                  `protobuf:"varint,3,opt,name=push_only,json=pushOnly,proto3" json:"push_only,omitempty"`
+	SnapshotCompressions []SnapshotCompression `protobuf:"varint,4,rep,packed,name=snapshot_compressions,json=snapshotCompressions,proto3,enum=api.SnapshotCompression" json:"snapshot_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *PushPullRequest) Reset()         { *m = PushPullRequest{} }
 func (m *PushPullRequest) String() string { return proto.CompactTextString(m) }
 func (*PushPullRequest) ProtoMessage()    {}
 func (*PushPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{12}
+	return fileDescriptor_9df40050e88fbc16, []int{15}
 }
 func (m *PushPullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,6 +1114,20 @@ func (m *PushPullRequest) GetChangePack() *ChangePack {
 	return nil
 }
 
+func (m *PushPullRequest) GetPushOnly() bool {
+	if m != nil {
+		return m.PushOnly
+	}
+	return false
+}
+
+func (m *PushPullRequest) GetSnapshotCompressions() []SnapshotCompression {
+	if m != nil {
+		return m.SnapshotCompressions
+	}
+	return nil
+}
+
 type PushPullResponse struct {
 	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
@@ -888,7 +1140,7 @@ func (m *PushPullResponse) Reset()         { *m = PushPullResponse{} }
 func (m *PushPullResponse) String() string { return proto.CompactTextString(m) }
 func (*PushPullResponse) ProtoMessage()    {}
 func (*PushPullResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{13}
+	return fileDescriptor_9df40050e88fbc16, []int{16}
 }
 func (m *PushPullResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +1195,7 @@ func (m *UpdateMetadataRequest) Reset()         { *m = UpdateMetadataRequest{} }
 func (m *UpdateMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateMetadataRequest) ProtoMessage()    {}
 func (*UpdateMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{14}
+	return fileDescriptor_9df40050e88fbc16, []int{17}
 }
 func (m *UpdateMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1248,7 @@ func (m *UpdateMetadataResponse) Reset()         { *m = UpdateMetadataResponse{}
 func (m *UpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateMetadataResponse) ProtoMessage()    {}
 func (*UpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{15}
+	return fileDescriptor_9df40050e88fbc16, []int{18}
 }
 func (m *UpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,29 +1277,27 @@ func (m *UpdateMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateMetadataResponse proto.InternalMessageInfo
 
-type ChangePack struct {
-	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
-	Checkpoint           *Checkpoint  `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
-	Snapshot             []byte       `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Changes              []*Change    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
-	MinSyncedTicket      *TimeTicket  `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type FetchDocumentRequest struct {
+	DocumentKey          *DocumentKey          `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	SnapshotCompressions []SnapshotCompression `protobuf:"varint,2,rep,packed,name=snapshot_compressions,json=snapshotCompressions,proto3,enum=api.SnapshotCompression" json:"snapshot_compressions,omitempty"`
+	ServerSeq            uint64                `protobuf:"varint,3,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ChangePack) Reset()         { *m = ChangePack{} }
-func (m *ChangePack) String() string { return proto.CompactTextString(m) }
-func (*ChangePack) ProtoMessage()    {}
-func (*ChangePack) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{16}
+func (m *FetchDocumentRequest) Reset()         { *m = FetchDocumentRequest{} }
+func (m *FetchDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchDocumentRequest) ProtoMessage()    {}
+func (*FetchDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{19}
 }
-func (m *ChangePack) XXX_Unmarshal(b []byte) error {
+func (m *FetchDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FetchDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePack.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FetchDocumentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1057,74 +1307,107 @@ func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChangePack) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePack.Merge(m, src)
+func (m *FetchDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchDocumentRequest.Merge(m, src)
 }
-func (m *ChangePack) XXX_Size() int {
+func (m *FetchDocumentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePack) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePack.DiscardUnknown(m)
+func (m *FetchDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchDocumentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePack proto.InternalMessageInfo
+var xxx_messageInfo_FetchDocumentRequest proto.InternalMessageInfo
 
-func (m *ChangePack) GetDocumentKey() *DocumentKey {
+func (m *FetchDocumentRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
 		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *ChangePack) GetCheckpoint() *Checkpoint {
+func (m *FetchDocumentRequest) GetSnapshotCompressions() []SnapshotCompression {
 	if m != nil {
-		return m.Checkpoint
+		return m.SnapshotCompressions
 	}
 	return nil
 }
 
-func (m *ChangePack) GetSnapshot() []byte {
+func (m *FetchDocumentRequest) GetServerSeq() uint64 {
 	if m != nil {
-		return m.Snapshot
+		return m.ServerSeq
 	}
-	return nil
+	return 0
 }
 
-func (m *ChangePack) GetChanges() []*Change {
-	if m != nil {
-		return m.Changes
-	}
-	return nil
+type FetchDocumentResponse struct {
+	ChangePack           *ChangePack `protobuf:"bytes,1,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ChangePack) GetMinSyncedTicket() *TimeTicket {
-	if m != nil {
-		return m.MinSyncedTicket
-	}
-	return nil
+func (m *FetchDocumentResponse) Reset()         { *m = FetchDocumentResponse{} }
+func (m *FetchDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchDocumentResponse) ProtoMessage()    {}
+func (*FetchDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{20}
 }
-
-type Change struct {
-	Id                   *ChangeID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message              string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Operations           []*Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *FetchDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Change) Reset()         { *m = Change{} }
-func (m *Change) String() string { return proto.CompactTextString(m) }
-func (*Change) ProtoMessage()    {}
-func (*Change) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{17}
+func (m *FetchDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (m *Change) XXX_Unmarshal(b []byte) error {
+func (m *FetchDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchDocumentResponse.Merge(m, src)
+}
+func (m *FetchDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchDocumentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchDocumentResponse proto.InternalMessageInfo
+
+func (m *FetchDocumentResponse) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
+	}
+	return nil
+}
+
+type BroadcastRequest struct {
+	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	Payload              []byte         `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BroadcastRequest) Reset()         { *m = BroadcastRequest{} }
+func (m *BroadcastRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastRequest) ProtoMessage()    {}
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{21}
+}
+func (m *BroadcastRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BroadcastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Change.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BroadcastRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1134,60 +1417,57 @@ func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Change) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Change.Merge(m, src)
+func (m *BroadcastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastRequest.Merge(m, src)
 }
-func (m *Change) XXX_Size() int {
+func (m *BroadcastRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Change) XXX_DiscardUnknown() {
-	xxx_messageInfo_Change.DiscardUnknown(m)
+func (m *BroadcastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Change proto.InternalMessageInfo
+var xxx_messageInfo_BroadcastRequest proto.InternalMessageInfo
 
-func (m *Change) GetId() *ChangeID {
+func (m *BroadcastRequest) GetClient() *Client {
 	if m != nil {
-		return m.Id
+		return m.Client
 	}
 	return nil
 }
 
-func (m *Change) GetMessage() string {
+func (m *BroadcastRequest) GetDocumentKeys() []*DocumentKey {
 	if m != nil {
-		return m.Message
+		return m.DocumentKeys
 	}
-	return ""
+	return nil
 }
 
-func (m *Change) GetOperations() []*Operation {
+func (m *BroadcastRequest) GetPayload() []byte {
 	if m != nil {
-		return m.Operations
+		return m.Payload
 	}
 	return nil
 }
 
-type ChangeID struct {
-	ClientSeq            uint32   `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
-	Lamport              uint64   `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+type BroadcastResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangeID) Reset()         { *m = ChangeID{} }
-func (m *ChangeID) String() string { return proto.CompactTextString(m) }
-func (*ChangeID) ProtoMessage()    {}
-func (*ChangeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{18}
+func (m *BroadcastResponse) Reset()         { *m = BroadcastResponse{} }
+func (m *BroadcastResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastResponse) ProtoMessage()    {}
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{22}
 }
-func (m *ChangeID) XXX_Unmarshal(b []byte) error {
+func (m *BroadcastResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BroadcastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BroadcastResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1197,68 +1477,103 @@ func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChangeID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeID.Merge(m, src)
+func (m *BroadcastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BroadcastResponse.Merge(m, src)
 }
-func (m *ChangeID) XXX_Size() int {
+func (m *BroadcastResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeID) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeID.DiscardUnknown(m)
+func (m *BroadcastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BroadcastResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeID proto.InternalMessageInfo
+var xxx_messageInfo_BroadcastResponse proto.InternalMessageInfo
 
-func (m *ChangeID) GetClientSeq() uint32 {
+type WatchChangesRequest struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	StartTime            int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchChangesRequest) Reset()         { *m = WatchChangesRequest{} }
+func (m *WatchChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchChangesRequest) ProtoMessage()    {}
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{23}
+}
+func (m *WatchChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchChangesRequest.Merge(m, src)
+}
+func (m *WatchChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchChangesRequest proto.InternalMessageInfo
+
+func (m *WatchChangesRequest) GetCollection() string {
 	if m != nil {
-		return m.ClientSeq
+		return m.Collection
 	}
-	return 0
+	return ""
 }
 
-func (m *ChangeID) GetLamport() uint64 {
+func (m *WatchChangesRequest) GetCursor() string {
 	if m != nil {
-		return m.Lamport
+		return m.Cursor
 	}
-	return 0
+	return ""
 }
 
-func (m *ChangeID) GetActorId() []byte {
+func (m *WatchChangesRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.ActorId
+		return m.StartTime
 	}
-	return nil
+	return 0
 }
 
-type Operation struct {
-	// Types that are valid to be assigned to Body:
-	//	*Operation_Set_
-	//	*Operation_Add_
-	//	*Operation_Move_
-	//	*Operation_Remove_
-	//	*Operation_Edit_
-	//	*Operation_Select_
-	//	*Operation_RichEdit_
-	//	*Operation_Style_
-	//	*Operation_Increase_
-	Body                 isOperation_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type WatchChangesResponse struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            uint64       `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Change               *Change      `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	Cursor               string       `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation) Reset()         { *m = Operation{} }
-func (m *Operation) String() string { return proto.CompactTextString(m) }
-func (*Operation) ProtoMessage()    {}
-func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19}
+func (m *WatchChangesResponse) Reset()         { *m = WatchChangesResponse{} }
+func (m *WatchChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchChangesResponse) ProtoMessage()    {}
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{24}
 }
-func (m *Operation) XXX_Unmarshal(b []byte) error {
+func (m *WatchChangesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchChangesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1268,93 +1583,376 @@ func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Operation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation.Merge(m, src)
+func (m *WatchChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchChangesResponse.Merge(m, src)
 }
-func (m *Operation) XXX_Size() int {
+func (m *WatchChangesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation.DiscardUnknown(m)
+func (m *WatchChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchChangesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation proto.InternalMessageInfo
+var xxx_messageInfo_WatchChangesResponse proto.InternalMessageInfo
 
-type isOperation_Body interface {
-	isOperation_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *WatchChangesResponse) GetDocumentKey() *DocumentKey {
+	if m != nil {
+		return m.DocumentKey
+	}
+	return nil
 }
 
-type Operation_Set_ struct {
-	Set *Operation_Set `protobuf:"bytes,1,opt,name=set,proto3,oneof" json:"set,omitempty"`
+func (m *WatchChangesResponse) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
+	}
+	return 0
 }
-type Operation_Add_ struct {
-	Add *Operation_Add `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+
+func (m *WatchChangesResponse) GetChange() *Change {
+	if m != nil {
+		return m.Change
+	}
+	return nil
 }
-type Operation_Move_ struct {
-	Move *Operation_Move `protobuf:"bytes,3,opt,name=move,proto3,oneof" json:"move,omitempty"`
+
+func (m *WatchChangesResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
-type Operation_Remove_ struct {
-	Remove *Operation_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+
+type ChangePack struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Checkpoint           *Checkpoint  `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Snapshot             []byte       `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Changes              []*Change    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	MinSyncedTicket      *TimeTicket  `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-type Operation_Edit_ struct {
-	Edit *Operation_Edit `protobuf:"bytes,5,opt,name=edit,proto3,oneof" json:"edit,omitempty"`
+
+func (m *ChangePack) Reset()         { *m = ChangePack{} }
+func (m *ChangePack) String() string { return proto.CompactTextString(m) }
+func (*ChangePack) ProtoMessage()    {}
+func (*ChangePack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{25}
 }
-type Operation_Select_ struct {
-	Select *Operation_Select `protobuf:"bytes,6,opt,name=select,proto3,oneof" json:"select,omitempty"`
+func (m *ChangePack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type Operation_RichEdit_ struct {
-	RichEdit *Operation_RichEdit `protobuf:"bytes,7,opt,name=rich_edit,json=richEdit,proto3,oneof" json:"rich_edit,omitempty"`
+func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type Operation_Style_ struct {
-	Style *Operation_Style `protobuf:"bytes,8,opt,name=style,proto3,oneof" json:"style,omitempty"`
+func (m *ChangePack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePack.Merge(m, src)
 }
-type Operation_Increase_ struct {
-	Increase *Operation_Increase `protobuf:"bytes,9,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
+func (m *ChangePack) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePack) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePack.DiscardUnknown(m)
 }
 
-func (*Operation_Set_) isOperation_Body()      {}
-func (*Operation_Add_) isOperation_Body()      {}
-func (*Operation_Move_) isOperation_Body()     {}
-func (*Operation_Remove_) isOperation_Body()   {}
-func (*Operation_Edit_) isOperation_Body()     {}
-func (*Operation_Select_) isOperation_Body()   {}
-func (*Operation_RichEdit_) isOperation_Body() {}
-func (*Operation_Style_) isOperation_Body()    {}
-func (*Operation_Increase_) isOperation_Body() {}
+var xxx_messageInfo_ChangePack proto.InternalMessageInfo
 
-func (m *Operation) GetBody() isOperation_Body {
+func (m *ChangePack) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.Body
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation) GetSet() *Operation_Set {
-	if x, ok := m.GetBody().(*Operation_Set_); ok {
-		return x.Set
+func (m *ChangePack) GetCheckpoint() *Checkpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
 	return nil
 }
 
-func (m *Operation) GetAdd() *Operation_Add {
-	if x, ok := m.GetBody().(*Operation_Add_); ok {
-		return x.Add
+func (m *ChangePack) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
 	}
 	return nil
 }
 
-func (m *Operation) GetMove() *Operation_Move {
-	if x, ok := m.GetBody().(*Operation_Move_); ok {
-		return x.Move
+func (m *ChangePack) GetChanges() []*Change {
+	if m != nil {
+		return m.Changes
 	}
 	return nil
 }
 
-func (m *Operation) GetRemove() *Operation_Remove {
-	if x, ok := m.GetBody().(*Operation_Remove_); ok {
-		return x.Remove
+func (m *ChangePack) GetMinSyncedTicket() *TimeTicket {
+	if m != nil {
+		return m.MinSyncedTicket
+	}
+	return nil
+}
+
+type Change struct {
+	Id                   *ChangeID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message              string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Operations           []*Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Change) Reset()         { *m = Change{} }
+func (m *Change) String() string { return proto.CompactTextString(m) }
+func (*Change) ProtoMessage()    {}
+func (*Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{26}
+}
+func (m *Change) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Change.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Change.Merge(m, src)
+}
+func (m *Change) XXX_Size() int {
+	return m.Size()
+}
+func (m *Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Change proto.InternalMessageInfo
+
+func (m *Change) GetId() *ChangeID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Change) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Change) GetOperations() []*Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type ChangeID struct {
+	ClientSeq            uint32   `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	Lamport              uint64   `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeID) Reset()         { *m = ChangeID{} }
+func (m *ChangeID) String() string { return proto.CompactTextString(m) }
+func (*ChangeID) ProtoMessage()    {}
+func (*ChangeID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{27}
+}
+func (m *ChangeID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeID.Merge(m, src)
+}
+func (m *ChangeID) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeID proto.InternalMessageInfo
+
+func (m *ChangeID) GetClientSeq() uint32 {
+	if m != nil {
+		return m.ClientSeq
+	}
+	return 0
+}
+
+func (m *ChangeID) GetLamport() uint64 {
+	if m != nil {
+		return m.Lamport
+	}
+	return 0
+}
+
+func (m *ChangeID) GetActorId() []byte {
+	if m != nil {
+		return m.ActorId
+	}
+	return nil
+}
+
+type Operation struct {
+	// Types that are valid to be assigned to Body:
+	//	*Operation_Set_
+	//	*Operation_Add_
+	//	*Operation_Move_
+	//	*Operation_Remove_
+	//	*Operation_Edit_
+	//	*Operation_Select_
+	//	*Operation_RichEdit_
+	//	*Operation_Style_
+	//	*Operation_Increase_
+	Body                 isOperation_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{28}
+}
+func (m *Operation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(m, src)
+}
+func (m *Operation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation proto.InternalMessageInfo
+
+type isOperation_Body interface {
+	isOperation_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Operation_Set_ struct {
+	Set *Operation_Set `protobuf:"bytes,1,opt,name=set,proto3,oneof" json:"set,omitempty"`
+}
+type Operation_Add_ struct {
+	Add *Operation_Add `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+}
+type Operation_Move_ struct {
+	Move *Operation_Move `protobuf:"bytes,3,opt,name=move,proto3,oneof" json:"move,omitempty"`
+}
+type Operation_Remove_ struct {
+	Remove *Operation_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+}
+type Operation_Edit_ struct {
+	Edit *Operation_Edit `protobuf:"bytes,5,opt,name=edit,proto3,oneof" json:"edit,omitempty"`
+}
+type Operation_Select_ struct {
+	Select *Operation_Select `protobuf:"bytes,6,opt,name=select,proto3,oneof" json:"select,omitempty"`
+}
+type Operation_RichEdit_ struct {
+	RichEdit *Operation_RichEdit `protobuf:"bytes,7,opt,name=rich_edit,json=richEdit,proto3,oneof" json:"rich_edit,omitempty"`
+}
+type Operation_Style_ struct {
+	Style *Operation_Style `protobuf:"bytes,8,opt,name=style,proto3,oneof" json:"style,omitempty"`
+}
+type Operation_Increase_ struct {
+	Increase *Operation_Increase `protobuf:"bytes,9,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
+}
+
+func (*Operation_Set_) isOperation_Body()      {}
+func (*Operation_Add_) isOperation_Body()      {}
+func (*Operation_Move_) isOperation_Body()     {}
+func (*Operation_Remove_) isOperation_Body()   {}
+func (*Operation_Edit_) isOperation_Body()     {}
+func (*Operation_Select_) isOperation_Body()   {}
+func (*Operation_RichEdit_) isOperation_Body() {}
+func (*Operation_Style_) isOperation_Body()    {}
+func (*Operation_Increase_) isOperation_Body() {}
+
+func (m *Operation) GetBody() isOperation_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Operation) GetSet() *Operation_Set {
+	if x, ok := m.GetBody().(*Operation_Set_); ok {
+		return x.Set
+	}
+	return nil
+}
+
+func (m *Operation) GetAdd() *Operation_Add {
+	if x, ok := m.GetBody().(*Operation_Add_); ok {
+		return x.Add
+	}
+	return nil
+}
+
+func (m *Operation) GetMove() *Operation_Move {
+	if x, ok := m.GetBody().(*Operation_Move_); ok {
+		return x.Move
+	}
+	return nil
+}
+
+func (m *Operation) GetRemove() *Operation_Remove {
+	if x, ok := m.GetBody().(*Operation_Remove_); ok {
+		return x.Remove
 	}
 	return nil
 }
@@ -1423,7 +2021,7 @@ func (m *Operation_Set) Reset()         { *m = Operation_Set{} }
 func (m *Operation_Set) String() string { return proto.CompactTextString(m) }
 func (*Operation_Set) ProtoMessage()    {}
 func (*Operation_Set) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 0}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 0}
 }
 func (m *Operation_Set) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1494,7 +2092,7 @@ func (m *Operation_Add) Reset()         { *m = Operation_Add{} }
 func (m *Operation_Add) String() string { return proto.CompactTextString(m) }
 func (*Operation_Add) ProtoMessage()    {}
 func (*Operation_Add) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 1}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 1}
 }
 func (m *Operation_Add) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1565,7 +2163,7 @@ func (m *Operation_Move) Reset()         { *m = Operation_Move{} }
 func (m *Operation_Move) String() string { return proto.CompactTextString(m) }
 func (*Operation_Move) ProtoMessage()    {}
 func (*Operation_Move) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 2}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 2}
 }
 func (m *Operation_Move) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2233,7 @@ func (m *Operation_Remove) Reset()         { *m = Operation_Remove{} }
 func (m *Operation_Remove) String() string { return proto.CompactTextString(m) }
 func (*Operation_Remove) ProtoMessage()    {}
 func (*Operation_Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 3}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 3}
 }
 func (m *Operation_Remove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1701,7 +2299,7 @@ func (m *Operation_Edit) Reset()         { *m = Operation_Edit{} }
 func (m *Operation_Edit) String() string { return proto.CompactTextString(m) }
 func (*Operation_Edit) ProtoMessage()    {}
 func (*Operation_Edit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 4}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 4}
 }
 func (m *Operation_Edit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2384,7 @@ func (m *Operation_Select) Reset()         { *m = Operation_Select{} }
 func (m *Operation_Select) String() string { return proto.CompactTextString(m) }
 func (*Operation_Select) ProtoMessage()    {}
 func (*Operation_Select) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 5}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 5}
 }
 func (m *Operation_Select) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,7 +2458,7 @@ func (m *Operation_RichEdit) Reset()         { *m = Operation_RichEdit{} }
 func (m *Operation_RichEdit) String() string { return proto.CompactTextString(m) }
 func (*Operation_RichEdit) ProtoMessage()    {}
 func (*Operation_RichEdit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 6}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 6}
 }
 func (m *Operation_RichEdit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1953,7 +2551,7 @@ func (m *Operation_Style) Reset()         { *m = Operation_Style{} }
 func (m *Operation_Style) String() string { return proto.CompactTextString(m) }
 func (*Operation_Style) ProtoMessage()    {}
 func (*Operation_Style) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 7}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 7}
 }
 func (m *Operation_Style) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2030,7 +2628,7 @@ func (m *Operation_Increase) Reset()         { *m = Operation_Increase{} }
 func (m *Operation_Increase) String() string { return proto.CompactTextString(m) }
 func (*Operation_Increase) ProtoMessage()    {}
 func (*Operation_Increase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 8}
+	return fileDescriptor_9df40050e88fbc16, []int{28, 8}
 }
 func (m *Operation_Increase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2095,7 +2693,7 @@ func (m *JSONElementSimple) Reset()         { *m = JSONElementSimple{} }
 func (m *JSONElementSimple) String() string { return proto.CompactTextString(m) }
 func (*JSONElementSimple) ProtoMessage()    {}
 func (*JSONElementSimple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{20}
+	return fileDescriptor_9df40050e88fbc16, []int{29}
 }
 func (m *JSONElementSimple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2177,7 +2775,7 @@ func (m *JSONElement) Reset()         { *m = JSONElement{} }
 func (m *JSONElement) String() string { return proto.CompactTextString(m) }
 func (*JSONElement) ProtoMessage()    {}
 func (*JSONElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21}
+	return fileDescriptor_9df40050e88fbc16, []int{30}
 }
 func (m *JSONElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2313,7 +2911,7 @@ func (m *JSONElement_JSONObject) Reset()         { *m = JSONElement_JSONObject{}
 func (m *JSONElement_JSONObject) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONObject) ProtoMessage()    {}
 func (*JSONElement_JSONObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 0}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 0}
 }
 func (m *JSONElement_JSONObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2384,7 +2982,7 @@ func (m *JSONElement_JSONArray) Reset()         { *m = JSONElement_JSONArray{} }
 func (m *JSONElement_JSONArray) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_JSONArray) ProtoMessage()    {}
 func (*JSONElement_JSONArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 1}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 1}
 }
 func (m *JSONElement_JSONArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2456,7 +3054,7 @@ func (m *JSONElement_Primitive) Reset()         { *m = JSONElement_Primitive{} }
 func (m *JSONElement_Primitive) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Primitive) ProtoMessage()    {}
 func (*JSONElement_Primitive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 2}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 2}
 }
 func (m *JSONElement_Primitive) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2534,7 +3132,7 @@ func (m *JSONElement_Text) Reset()         { *m = JSONElement_Text{} }
 func (m *JSONElement_Text) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Text) ProtoMessage()    {}
 func (*JSONElement_Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 3}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 3}
 }
 func (m *JSONElement_Text) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2605,7 +3203,7 @@ func (m *JSONElement_RichText) Reset()         { *m = JSONElement_RichText{} }
 func (m *JSONElement_RichText) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_RichText) ProtoMessage()    {}
 func (*JSONElement_RichText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 4}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 4}
 }
 func (m *JSONElement_RichText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2677,7 +3275,7 @@ func (m *JSONElement_Counter) Reset()         { *m = JSONElement_Counter{} }
 func (m *JSONElement_Counter) String() string { return proto.CompactTextString(m) }
 func (*JSONElement_Counter) ProtoMessage()    {}
 func (*JSONElement_Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 5}
+	return fileDescriptor_9df40050e88fbc16, []int{30, 5}
 }
 func (m *JSONElement_Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2753,7 +3351,7 @@ func (m *RHTNode) Reset()         { *m = RHTNode{} }
 func (m *RHTNode) String() string { return proto.CompactTextString(m) }
 func (*RHTNode) ProtoMessage()    {}
 func (*RHTNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{22}
+	return fileDescriptor_9df40050e88fbc16, []int{31}
 }
 func (m *RHTNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2808,7 +3406,7 @@ func (m *RGANode) Reset()         { *m = RGANode{} }
 func (m *RGANode) String() string { return proto.CompactTextString(m) }
 func (*RGANode) ProtoMessage()    {}
 func (*RGANode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{23}
+	return fileDescriptor_9df40050e88fbc16, []int{32}
 }
 func (m *RGANode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2865,7 +3463,7 @@ func (m *TextNode) Reset()         { *m = TextNode{} }
 func (m *TextNode) String() string { return proto.CompactTextString(m) }
 func (*TextNode) ProtoMessage()    {}
 func (*TextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{24}
+	return fileDescriptor_9df40050e88fbc16, []int{33}
 }
 func (m *TextNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2935,7 +3533,7 @@ func (m *RichTextNodeAttr) Reset()         { *m = RichTextNodeAttr{} }
 func (m *RichTextNodeAttr) String() string { return proto.CompactTextString(m) }
 func (*RichTextNodeAttr) ProtoMessage()    {}
 func (*RichTextNodeAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{25}
+	return fileDescriptor_9df40050e88fbc16, []int{34}
 }
 func (m *RichTextNodeAttr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3000,7 +3598,7 @@ func (m *RichTextNode) Reset()         { *m = RichTextNode{} }
 func (m *RichTextNode) String() string { return proto.CompactTextString(m) }
 func (*RichTextNode) ProtoMessage()    {}
 func (*RichTextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{26}
+	return fileDescriptor_9df40050e88fbc16, []int{35}
 }
 func (m *RichTextNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3076,7 +3674,7 @@ func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
 func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
 func (*TextNodeID) ProtoMessage()    {}
 func (*TextNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{27}
+	return fileDescriptor_9df40050e88fbc16, []int{36}
 }
 func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3131,7 +3729,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{28}
+	return fileDescriptor_9df40050e88fbc16, []int{37}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3186,7 +3784,7 @@ func (m *Client) Reset()         { *m = Client{} }
 func (m *Client) String() string { return proto.CompactTextString(m) }
 func (*Client) ProtoMessage()    {}
 func (*Client) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{29}
+	return fileDescriptor_9df40050e88fbc16, []int{38}
 }
 func (m *Client) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3240,7 +3838,7 @@ func (m *Clients) Reset()         { *m = Clients{} }
 func (m *Clients) String() string { return proto.CompactTextString(m) }
 func (*Clients) ProtoMessage()    {}
 func (*Clients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{30}
+	return fileDescriptor_9df40050e88fbc16, []int{39}
 }
 func (m *Clients) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3288,7 +3886,7 @@ func (m *DocumentKey) Reset()         { *m = DocumentKey{} }
 func (m *DocumentKey) String() string { return proto.CompactTextString(m) }
 func (*DocumentKey) ProtoMessage()    {}
 func (*DocumentKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{31}
+	return fileDescriptor_9df40050e88fbc16, []int{40}
 }
 func (m *DocumentKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +3941,7 @@ func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{32}
+	return fileDescriptor_9df40050e88fbc16, []int{41}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +3997,7 @@ func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
 func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
 func (*TextNodePos) ProtoMessage()    {}
 func (*TextNodePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{33}
+	return fileDescriptor_9df40050e88fbc16, []int{42}
 }
 func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +4060,7 @@ func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
 func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
 func (*TimeTicket) ProtoMessage()    {}
 func (*TimeTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{34}
+	return fileDescriptor_9df40050e88fbc16, []int{43}
 }
 func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,6 +4114,8 @@ type DocEvent struct {
 	Type                 DocEventType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.DocEventType" json:"type,omitempty"`
 	Publisher            *Client        `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
 	DocumentKeys         []*DocumentKey `protobuf:"bytes,3,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	Payload              []byte         `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	ChangePack           *ChangePack    `protobuf:"bytes,5,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -3525,7 +4125,7 @@ func (m *DocEvent) Reset()         { *m = DocEvent{} }
 func (m *DocEvent) String() string { return proto.CompactTextString(m) }
 func (*DocEvent) ProtoMessage()    {}
 func (*DocEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{35}
+	return fileDescriptor_9df40050e88fbc16, []int{44}
 }
 func (m *DocEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3575,11 +4175,28 @@ func (m *DocEvent) GetDocumentKeys() []*DocumentKey {
 	return nil
 }
 
+func (m *DocEvent) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *DocEvent) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("api.ValueType", ValueType_name, ValueType_value)
+	proto.RegisterEnum("api.SnapshotCompression", SnapshotCompression_name, SnapshotCompression_value)
 	proto.RegisterEnum("api.DocEventType", DocEventType_name, DocEventType_value)
 	proto.RegisterType((*BroadcastEventRequest)(nil), "api.BroadcastEventRequest")
 	proto.RegisterType((*BroadcastEventResponse)(nil), "api.BroadcastEventResponse")
+	proto.RegisterType((*PurgeAuthCacheRequest)(nil), "api.PurgeAuthCacheRequest")
+	proto.RegisterType((*PurgeAuthCacheResponse)(nil), "api.PurgeAuthCacheResponse")
 	proto.RegisterType((*ActivateClientRequest)(nil), "api.ActivateClientRequest")
 	proto.RegisterType((*ActivateClientResponse)(nil), "api.ActivateClientResponse")
 	proto.RegisterType((*DeactivateClientRequest)(nil), "api.DeactivateClientRequest")
@@ -3592,10 +4209,17 @@ func init() {
 	proto.RegisterType((*WatchDocumentsResponse)(nil), "api.WatchDocumentsResponse")
 	proto.RegisterType((*WatchDocumentsResponse_Initialization)(nil), "api.WatchDocumentsResponse.Initialization")
 	proto.RegisterMapType((map[string]*Clients)(nil), "api.WatchDocumentsResponse.Initialization.PeersMapByDocEntry")
+	proto.RegisterType((*WatchDocumentsStreamRequest)(nil), "api.WatchDocumentsStreamRequest")
 	proto.RegisterType((*PushPullRequest)(nil), "api.PushPullRequest")
 	proto.RegisterType((*PushPullResponse)(nil), "api.PushPullResponse")
 	proto.RegisterType((*UpdateMetadataRequest)(nil), "api.UpdateMetadataRequest")
 	proto.RegisterType((*UpdateMetadataResponse)(nil), "api.UpdateMetadataResponse")
+	proto.RegisterType((*FetchDocumentRequest)(nil), "api.FetchDocumentRequest")
+	proto.RegisterType((*FetchDocumentResponse)(nil), "api.FetchDocumentResponse")
+	proto.RegisterType((*BroadcastRequest)(nil), "api.BroadcastRequest")
+	proto.RegisterType((*BroadcastResponse)(nil), "api.BroadcastResponse")
+	proto.RegisterType((*WatchChangesRequest)(nil), "api.WatchChangesRequest")
+	proto.RegisterType((*WatchChangesResponse)(nil), "api.WatchChangesResponse")
 	proto.RegisterType((*ChangePack)(nil), "api.ChangePack")
 	proto.RegisterType((*Change)(nil), "api.Change")
 	proto.RegisterType((*ChangeID)(nil), "api.ChangeID")
@@ -3642,159 +4266,190 @@ func init() {
 func init() { proto.RegisterFile("api/yorkie.proto", fileDescriptor_9df40050e88fbc16) }
 
 var fileDescriptor_9df40050e88fbc16 = []byte{
-	// 2425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x55, 0xd4, 0x37, 0x9f, 0x64, 0x99, 0x99, 0xc4, 0x8e, 0x56, 0xf9, 0x68, 0xc2, 0x6c, 0x9a, 0x8f,
-	0x0d, 0x9c, 0xc0, 0xdb, 0x4d, 0xb6, 0xbb, 0xd8, 0x02, 0xb4, 0x24, 0x58, 0xda, 0xc4, 0xb2, 0x4b,
-	0x29, 0x9b, 0xe6, 0xa4, 0xd2, 0xe4, 0x38, 0x66, 0x2c, 0x89, 0x0c, 0x49, 0x19, 0x51, 0x0f, 0x3d,
-	0xf6, 0xd0, 0x6b, 0x7b, 0x68, 0xaf, 0x45, 0x81, 0xfd, 0x03, 0x05, 0x7a, 0x68, 0x81, 0x1c, 0x7a,
-	0xc9, 0x6d, 0xdb, 0x63, 0x51, 0xa0, 0x28, 0xd2, 0x4b, 0xcf, 0xfd, 0x05, 0xc5, 0x7c, 0x90, 0x22,
-	0x29, 0xda, 0xb2, 0x9a, 0xcd, 0xae, 0xb1, 0x37, 0xce, 0xbc, 0xef, 0x79, 0x6f, 0xde, 0x7b, 0x9c,
-	0x19, 0x90, 0x34, 0xdb, 0xbc, 0x3b, 0xb1, 0x9c, 0x03, 0x13, 0xaf, 0xd9, 0x8e, 0xe5, 0x59, 0x28,
-	0xa3, 0xd9, 0xa6, 0xdc, 0x87, 0x95, 0x0d, 0xc7, 0xd2, 0x0c, 0x5d, 0x73, 0xbd, 0xe6, 0x21, 0x1e,
-	0x79, 0x2a, 0x7e, 0x31, 0xc6, 0xae, 0x87, 0xae, 0x42, 0xd9, 0x1e, 0xef, 0x0e, 0x4c, 0x77, 0x1f,
-	0x3b, 0x7d, 0xd3, 0xa8, 0x0a, 0x57, 0x84, 0x9b, 0x65, 0xb5, 0x14, 0xcc, 0xb5, 0x0d, 0x74, 0x0d,
-	0x72, 0x98, 0x90, 0x54, 0xd3, 0x57, 0x84, 0x9b, 0xa5, 0xf5, 0xa5, 0x35, 0xcd, 0x36, 0xd7, 0x1a,
-	0x96, 0xce, 0xf8, 0x30, 0x98, 0x5c, 0x85, 0xd5, 0xb8, 0x00, 0xd7, 0xb6, 0x46, 0x2e, 0x96, 0xef,
-	0xc3, 0x8a, 0xa2, 0x7b, 0xe6, 0xa1, 0xe6, 0xe1, 0xfa, 0xc0, 0x0c, 0x89, 0xbe, 0x04, 0xa0, 0xd3,
-	0x89, 0xfe, 0x01, 0x9e, 0x50, 0xc1, 0xa2, 0x2a, 0xb2, 0x99, 0x87, 0x78, 0x22, 0xf7, 0x60, 0x35,
-	0x4e, 0xc7, 0x38, 0xce, 0x21, 0x44, 0x17, 0x80, 0x0f, 0x88, 0x3d, 0x69, 0x6a, 0x4f, 0x91, 0x4d,
-	0xb4, 0x0d, 0xf9, 0x3e, 0x9c, 0x6f, 0x60, 0x2d, 0x51, 0x9f, 0x08, 0x9d, 0x10, 0xa3, 0x7b, 0x00,
-	0xd5, 0x59, 0x3a, 0xae, 0xcf, 0xb1, 0x84, 0x7b, 0xb0, 0xa2, 0x78, 0x9e, 0xa6, 0xef, 0x37, 0x2c,
-	0x7d, 0x3c, 0x3c, 0xa1, 0x38, 0x74, 0x0f, 0x4a, 0xfa, 0xbe, 0x36, 0x7a, 0x86, 0xfb, 0xb6, 0xa6,
-	0x1f, 0xf0, 0x95, 0x5f, 0xa6, 0x2b, 0x5f, 0xa7, 0xf3, 0x3b, 0x9a, 0x7e, 0xa0, 0x82, 0x1e, 0x7c,
-	0xcb, 0xcf, 0x60, 0x35, 0x2e, 0xe7, 0x04, 0xea, 0xfd, 0x1f, 0x82, 0xf6, 0x60, 0xa5, 0x81, 0xbf,
-	0x01, 0x83, 0x4c, 0x58, 0x8d, 0xcb, 0x39, 0x99, 0xff, 0x17, 0x17, 0xe5, 0xc2, 0xca, 0x13, 0xcd,
-	0x9b, 0x4a, 0x72, 0x7d, 0x93, 0xae, 0x41, 0x9e, 0xf1, 0xa5, 0x52, 0x4a, 0xeb, 0x25, 0xc6, 0x85,
-	0xb9, 0x9f, 0x83, 0xd0, 0x47, 0xb0, 0x64, 0x70, 0x42, 0xa2, 0x90, 0x5b, 0x4d, 0x5f, 0xc9, 0xdc,
-	0x2c, 0xad, 0x4b, 0xfe, 0x3e, 0xa1, 0x90, 0x87, 0x78, 0xa2, 0x96, 0x8d, 0xe9, 0xc0, 0x95, 0xff,
-	0x93, 0x86, 0xd5, 0xb8, 0x54, 0x6e, 0x60, 0x0f, 0x2a, 0xe6, 0xc8, 0xf4, 0x4c, 0x6d, 0x60, 0xfe,
-	0x4c, 0xf3, 0x4c, 0x6b, 0xc4, 0xc5, 0xdf, 0xa6, 0x2c, 0x93, 0x89, 0xd6, 0xda, 0x11, 0x8a, 0x56,
-	0x4a, 0x8d, 0xf1, 0x40, 0xd7, 0x8f, 0xdb, 0xc7, 0xad, 0x14, 0xdf, 0xc9, 0xb5, 0xd7, 0x02, 0x54,
-	0xa2, 0xbc, 0xd0, 0x1e, 0x48, 0x36, 0xc6, 0x8e, 0xdb, 0x1f, 0x6a, 0x76, 0x7f, 0x77, 0xd2, 0x37,
-	0x2c, 0xbd, 0x2a, 0x50, 0x23, 0x3f, 0x3b, 0xb9, 0x46, 0x6b, 0x3b, 0x84, 0xc5, 0x96, 0x66, 0x6f,
-	0x4c, 0x88, 0xd0, 0x91, 0xe7, 0x4c, 0xd4, 0x25, 0x3b, 0x3c, 0x57, 0xeb, 0x00, 0x9a, 0x45, 0x42,
-	0x12, 0x64, 0xa6, 0x7e, 0x26, 0x9f, 0x48, 0x86, 0xdc, 0xa1, 0x36, 0x18, 0x63, 0x6e, 0x49, 0x39,
-	0xe4, 0x15, 0x57, 0x65, 0xa0, 0x4f, 0xd2, 0x1f, 0x0b, 0x1b, 0x79, 0xc8, 0xee, 0x5a, 0xc6, 0x44,
-	0xfe, 0x29, 0x2c, 0xef, 0x8c, 0xdd, 0xfd, 0x9d, 0xf1, 0x60, 0xf0, 0x8e, 0x82, 0x55, 0x03, 0x69,
-	0x2a, 0xe1, 0xdd, 0xec, 0x3b, 0x17, 0x56, 0x1e, 0xdb, 0x86, 0xe6, 0xe1, 0x2d, 0xec, 0x69, 0x86,
-	0xe6, 0x69, 0xdf, 0x44, 0x90, 0x56, 0x61, 0x35, 0x2e, 0x94, 0xa7, 0xf5, 0xff, 0x0a, 0x00, 0x53,
-	0x4d, 0xd1, 0x87, 0x50, 0x0e, 0xf3, 0xe7, 0xaa, 0xcc, 0xb2, 0x2f, 0x85, 0xd8, 0xa3, 0xbb, 0x00,
-	0xfa, 0x3e, 0xd6, 0x0f, 0x6c, 0xcb, 0x0c, 0xc2, 0xd2, 0x5f, 0x03, 0x7f, 0x5a, 0x0d, 0xa1, 0xa0,
-	0x1a, 0x14, 0xdd, 0x91, 0x66, 0xbb, 0xfb, 0x96, 0x57, 0xcd, 0xb0, 0x15, 0xf5, 0xc7, 0xe8, 0x3a,
-	0x14, 0xd8, 0x6a, 0xb9, 0xd5, 0x2c, 0xb5, 0xad, 0x14, 0x5a, 0x4d, 0xd5, 0x87, 0xa1, 0x4f, 0xe1,
-	0xcc, 0xd0, 0x1c, 0xf5, 0xdd, 0xc9, 0x48, 0xc7, 0x46, 0xdf, 0x33, 0xf5, 0x03, 0xec, 0x55, 0x73,
-	0x21, 0xd1, 0x3d, 0x73, 0x88, 0x7b, 0x74, 0x5a, 0x5d, 0x1e, 0x9a, 0xa3, 0x2e, 0x45, 0x64, 0x13,
-	0xf2, 0x0b, 0xc8, 0x33, 0x7e, 0xe8, 0x12, 0xa4, 0xb9, 0x57, 0xfd, 0x9d, 0xc4, 0x00, 0xed, 0x86,
-	0x9a, 0x36, 0x0d, 0x54, 0x85, 0xc2, 0x10, 0xbb, 0xae, 0xf6, 0x8c, 0xc5, 0xa8, 0xa8, 0xfa, 0x43,
-	0xb4, 0x06, 0x60, 0xd9, 0xd8, 0xa1, 0x5b, 0xc2, 0xad, 0x66, 0xa8, 0xa6, 0x15, 0xca, 0x60, 0xdb,
-	0x9f, 0x56, 0x43, 0x18, 0xf2, 0x2e, 0x14, 0x7d, 0xce, 0xa1, 0xc4, 0xe7, 0xe2, 0x17, 0x54, 0xf8,
-	0x92, 0x9f, 0xf8, 0xba, 0xf8, 0x05, 0xba, 0x08, 0x85, 0x81, 0x36, 0xb4, 0x2d, 0x87, 0xad, 0x65,
-	0x76, 0x23, 0x7d, 0x4f, 0x50, 0xfd, 0x29, 0xf4, 0x1e, 0x14, 0x35, 0xdd, 0xb3, 0x68, 0x95, 0x67,
-	0x6b, 0x57, 0xa0, 0xe3, 0xb6, 0x21, 0xbf, 0x5e, 0x05, 0x31, 0x90, 0x8e, 0xbe, 0x0f, 0x19, 0x17,
-	0xfb, 0xc1, 0x84, 0xa2, 0xaa, 0xad, 0x75, 0x31, 0x49, 0x15, 0x04, 0x81, 0xe0, 0x69, 0x86, 0xc1,
-	0xdd, 0x16, 0xc7, 0x53, 0x0c, 0x83, 0xe0, 0x69, 0x86, 0x81, 0x6e, 0x41, 0x76, 0x68, 0x1d, 0x62,
-	0x2a, 0xb4, 0xb4, 0x7e, 0x36, 0x86, 0xb8, 0x65, 0x1d, 0xe2, 0x56, 0x4a, 0xa5, 0x28, 0xe8, 0x2e,
-	0xe4, 0x1d, 0x4c, 0x91, 0xb3, 0x14, 0x79, 0x25, 0x86, 0xac, 0x52, 0x60, 0x2b, 0xa5, 0x72, 0x34,
-	0xc2, 0x1b, 0x1b, 0xa6, 0xef, 0xc0, 0x38, 0xef, 0xa6, 0x61, 0x12, 0x6d, 0x29, 0x0a, 0xe1, 0xed,
-	0xe2, 0x01, 0xd6, 0xbd, 0x6a, 0x3e, 0x91, 0x77, 0x97, 0x02, 0x09, 0x6f, 0x86, 0x86, 0xee, 0x83,
-	0xe8, 0x98, 0xfa, 0x7e, 0x9f, 0x0a, 0x28, 0x50, 0x9a, 0xf3, 0x71, 0x7d, 0x4c, 0x7d, 0x9f, 0x0b,
-	0x29, 0x3a, 0xfc, 0x1b, 0xdd, 0x81, 0x9c, 0xeb, 0x4d, 0x06, 0xb8, 0x5a, 0xa4, 0x34, 0xe7, 0xe2,
-	0x72, 0x08, 0x8c, 0xa4, 0x5b, 0x8a, 0x84, 0x3e, 0x82, 0xa2, 0x39, 0xd2, 0x1d, 0xac, 0xb9, 0xb8,
-	0x2a, 0x26, 0x0a, 0x69, 0x73, 0x30, 0x11, 0xe2, 0xa3, 0xd6, 0xfe, 0x20, 0x40, 0xa6, 0x8b, 0x3d,
-	0x12, 0xce, 0xb6, 0xe6, 0x90, 0x90, 0x20, 0x00, 0x0f, 0x1b, 0x7d, 0xcd, 0x77, 0xdd, 0x6c, 0x38,
-	0x33, 0xcc, 0x3a, 0x43, 0x54, 0x3c, 0x3f, 0xb3, 0xa6, 0xa7, 0x99, 0xf5, 0x8e, 0x9f, 0x59, 0x99,
-	0xb3, 0x56, 0x29, 0x8b, 0xcf, 0xbb, 0xdb, 0x9d, 0xe6, 0x00, 0x93, 0x5d, 0xdb, 0x35, 0x87, 0xf6,
-	0x00, 0xf3, 0x1c, 0x4b, 0x92, 0x18, 0x7e, 0x89, 0xf5, 0x31, 0x17, 0x9b, 0x4d, 0x16, 0x0b, 0x3e,
-	0x8e, 0xe2, 0xd5, 0xfe, 0x21, 0x40, 0x46, 0x31, 0x8c, 0xb7, 0x53, 0xfb, 0x01, 0x2c, 0xdb, 0x0e,
-	0x3e, 0x0c, 0x93, 0xa6, 0x93, 0x49, 0x97, 0x08, 0xde, 0x94, 0xf0, 0x5d, 0x5b, 0xf7, 0x4f, 0x01,
-	0xb2, 0x24, 0x9e, 0xbf, 0x25, 0xf3, 0xd6, 0x00, 0x42, 0x34, 0x99, 0x64, 0x1a, 0x51, 0x0f, 0xf0,
-	0x17, 0x37, 0xf0, 0x4b, 0x01, 0xf2, 0x6c, 0x0f, 0xbe, 0x9d, 0x89, 0x51, 0x4d, 0xd3, 0x8b, 0x6a,
-	0x9a, 0x99, 0xaf, 0xe9, 0xaf, 0x33, 0x90, 0xa5, 0xbb, 0xf1, 0xad, 0xf4, 0x7c, 0x1f, 0xb2, 0x7b,
-	0x8e, 0x35, 0xe4, 0x1a, 0xb2, 0x6a, 0xd6, 0xc3, 0x2f, 0xbd, 0x8e, 0x65, 0xe0, 0x1d, 0xcb, 0x55,
-	0x29, 0x14, 0x5d, 0x81, 0xb4, 0x67, 0x71, 0xa5, 0x66, 0x71, 0xd2, 0x9e, 0x85, 0x76, 0xe1, 0xfc,
-	0x54, 0xba, 0xdf, 0x45, 0xd1, 0xec, 0xcb, 0x6b, 0xd5, 0x9d, 0x84, 0xcc, 0xb5, 0x16, 0xe8, 0x41,
-	0xfb, 0x21, 0x85, 0xa0, 0xb3, 0xb6, 0xe9, 0xac, 0x3e, 0x0b, 0x21, 0x25, 0x47, 0xb7, 0x46, 0x1e,
-	0xe9, 0x03, 0x72, 0xac, 0xe4, 0xf0, 0x61, 0x7c, 0xf5, 0xf2, 0xf3, 0x57, 0xef, 0x09, 0x54, 0x8f,
-	0x12, 0x9e, 0xd0, 0x8e, 0x5d, 0x8f, 0xb6, 0x63, 0x33, 0x9c, 0xa7, 0x1d, 0x59, 0xed, 0x95, 0x00,
-	0x79, 0x96, 0x68, 0x4f, 0x87, 0x63, 0x16, 0xdf, 0x02, 0xbf, 0xcf, 0x42, 0xd1, 0x4f, 0xfb, 0xa7,
-	0xc3, 0x86, 0xbd, 0x79, 0xc1, 0x75, 0xef, 0x88, 0xaa, 0xf5, 0xb5, 0x05, 0xd8, 0x26, 0x80, 0xe6,
-	0x79, 0x8e, 0xb9, 0x3b, 0xf6, 0xb0, 0x5b, 0xcd, 0x53, 0xa1, 0x37, 0x8e, 0x12, 0xaa, 0x04, 0x98,
-	0x4c, 0x56, 0x88, 0x34, 0xee, 0x8e, 0xc2, 0xb7, 0x18, 0xa9, 0x9f, 0xc1, 0x72, 0x4c, 0xd3, 0x04,
-	0x7e, 0xe7, 0xc2, 0xfc, 0xc4, 0x30, 0xf9, 0x5f, 0xd2, 0x90, 0xa3, 0x95, 0xfe, 0x74, 0xc4, 0x48,
-	0x23, 0xe2, 0x21, 0x16, 0x16, 0xef, 0x27, 0x35, 0x26, 0x8b, 0xb8, 0x27, 0x37, 0xdf, 0x3d, 0x6f,
-	0xb9, 0x8a, 0x5f, 0x0a, 0x50, 0xf4, 0xdb, 0x9f, 0xb7, 0x5b, 0xc8, 0x3b, 0x51, 0xcf, 0x2f, 0x56,
-	0xfa, 0xe7, 0xd7, 0x9b, 0xe0, 0x57, 0xf3, 0xef, 0x02, 0x9c, 0x99, 0x61, 0x1b, 0xab, 0x77, 0xc2,
-	0xdc, 0x7a, 0x77, 0x1b, 0x8a, 0xa4, 0xc8, 0x1e, 0x57, 0x1d, 0x0b, 0x14, 0x81, 0xd5, 0x52, 0xd6,
-	0x0c, 0x1f, 0x5b, 0xf5, 0x39, 0x8a, 0xe2, 0x21, 0x19, 0xb2, 0xde, 0xc4, 0x66, 0x1d, 0x76, 0x85,
-	0xff, 0x7a, 0x7c, 0x41, 0xac, 0xee, 0x4d, 0x6c, 0xac, 0x52, 0xd8, 0xd4, 0x23, 0x39, 0xfa, 0xa3,
-	0xc0, 0x06, 0xf2, 0x2f, 0xcb, 0x50, 0x0a, 0xd9, 0x86, 0x7e, 0x04, 0xa5, 0xe7, 0xae, 0x35, 0xea,
-	0x5b, 0xbb, 0xcf, 0x49, 0x5b, 0xcd, 0xcc, 0xba, 0x10, 0x5f, 0x59, 0xfa, 0xbd, 0x4d, 0x51, 0x5a,
-	0x29, 0x15, 0x08, 0x05, 0x1b, 0xa1, 0x4f, 0x81, 0x8e, 0xfa, 0x9a, 0xe3, 0x68, 0x13, 0x6e, 0x67,
-	0x2d, 0x91, 0x5c, 0x21, 0x18, 0xad, 0x94, 0x2a, 0x12, 0x7c, 0x3a, 0x40, 0x9f, 0x80, 0x68, 0x3b,
-	0xe6, 0xd0, 0xf4, 0xcc, 0xe0, 0xd7, 0x62, 0x96, 0x76, 0xc7, 0xc7, 0x20, 0xb4, 0x01, 0x3a, 0xfa,
-	0x00, 0xb2, 0x1e, 0x7e, 0xe9, 0x45, 0x7e, 0x32, 0xc2, 0x64, 0x64, 0xf7, 0x90, 0xff, 0x06, 0x82,
-	0x84, 0x3e, 0xe6, 0xbf, 0x01, 0x94, 0x82, 0x85, 0xfc, 0x7b, 0x33, 0x14, 0x24, 0xbb, 0x71, 0x2a,
-	0xfa, 0x23, 0x40, 0xbe, 0xd1, 0x0f, 0x48, 0xc2, 0x1c, 0x8f, 0x3c, 0xec, 0xf0, 0x9a, 0x5b, 0x9d,
-	0xa1, 0xab, 0x33, 0x78, 0x2b, 0xa5, 0xfa, 0xa8, 0xb5, 0x3f, 0x0b, 0x00, 0xd3, 0x25, 0x43, 0x32,
-	0xe4, 0x46, 0x96, 0x81, 0x5d, 0x7e, 0xe0, 0xc2, 0xce, 0x3a, 0xd4, 0x56, 0x8f, 0xec, 0x6e, 0x95,
-	0x81, 0x16, 0x6e, 0xa7, 0xc2, 0xe1, 0x95, 0x59, 0x28, 0xbc, 0xb2, 0xf3, 0xc2, 0xab, 0xf6, 0x27,
-	0x01, 0xc4, 0xc0, 0x65, 0x47, 0x68, 0xbf, 0xa9, 0x9c, 0x56, 0xed, 0xff, 0x26, 0x80, 0x18, 0x04,
-	0x4d, 0xb0, 0x55, 0x84, 0x93, 0x6c, 0x95, 0x74, 0x68, 0xab, 0x2c, 0xdc, 0x8a, 0x87, 0x6d, 0xca,
-	0x2e, 0x64, 0x53, 0x6e, 0xae, 0x4d, 0x7f, 0x14, 0x20, 0x4b, 0xe3, 0xf1, 0x5a, 0xd4, 0x19, 0x4b,
-	0x91, 0x4a, 0x71, 0x1a, 0xbd, 0xf1, 0x4a, 0x60, 0xbd, 0x16, 0xd5, 0xfe, 0x46, 0x54, 0xfb, 0x33,
-	0x2c, 0x94, 0x38, 0xf4, 0xb4, 0x5a, 0xf0, 0x95, 0x00, 0x05, 0xbe, 0xc7, 0xbf, 0x1b, 0xd1, 0x44,
-	0x0a, 0xdd, 0x06, 0x29, 0x74, 0x9b, 0x50, 0xe0, 0x59, 0x28, 0xa1, 0xa2, 0xdf, 0x86, 0x02, 0x66,
-	0x19, 0x2e, 0xd2, 0xb9, 0x84, 0x32, 0x9f, 0xea, 0x23, 0xc8, 0x4f, 0xa0, 0xc0, 0x13, 0x02, 0xba,
-	0x02, 0xd9, 0x11, 0xc9, 0xb2, 0x42, 0xe8, 0x58, 0xd7, 0x4f, 0x16, 0x14, 0xb2, 0x10, 0xe3, 0xdf,
-	0x09, 0x50, 0xf4, 0x63, 0x03, 0x7d, 0x2f, 0x74, 0x5e, 0xb7, 0x1c, 0x09, 0x7c, 0x7e, 0x62, 0x97,
-	0xd8, 0x84, 0x2c, 0x5c, 0x5c, 0xef, 0x42, 0xc9, 0x1c, 0xb9, 0x7d, 0xfa, 0xff, 0x6e, 0x1a, 0xd1,
-	0xc5, 0x9f, 0xca, 0x13, 0xcd, 0x91, 0xbb, 0xe3, 0xe0, 0xc3, 0xb6, 0x21, 0x3f, 0x07, 0x29, 0x1c,
-	0xc3, 0xa4, 0x59, 0x3a, 0x69, 0x87, 0x44, 0x94, 0x1b, 0xd3, 0xc3, 0xd9, 0x63, 0x95, 0xe3, 0x28,
-	0x8a, 0x27, 0xbf, 0x4a, 0x43, 0x39, 0x2c, 0x6c, 0xfe, 0xa2, 0x28, 0x91, 0xb6, 0x91, 0x1d, 0x19,
-	0x5f, 0x9d, 0xd9, 0x78, 0xc7, 0xf6, 0x8c, 0xe7, 0xc2, 0x67, 0x2e, 0x47, 0xac, 0x6b, 0x76, 0xd1,
-	0x75, 0xcd, 0xcd, 0x5b, 0xd7, 0x5a, 0xef, 0x24, 0x8d, 0xe7, 0x07, 0xd1, 0xa6, 0x70, 0x65, 0xc6,
-	0x32, 0xc2, 0x22, 0xd4, 0x8f, 0xca, 0x3d, 0x80, 0xa9, 0xb8, 0x85, 0xbb, 0xba, 0x55, 0xc8, 0x5b,
-	0x7b, 0x7b, 0x2e, 0x66, 0xb1, 0x9b, 0x53, 0xf9, 0x48, 0xfe, 0x85, 0x00, 0x45, 0xff, 0x7c, 0x9d,
-	0xac, 0x97, 0x3e, 0xb0, 0xf4, 0x03, 0xca, 0x2f, 0xa7, 0xb2, 0x01, 0xe9, 0x58, 0x08, 0x94, 0xbb,
-	0x80, 0x9d, 0x10, 0xfa, 0x24, 0x6b, 0x0d, 0xcd, 0xd3, 0xd8, 0xc2, 0x53, 0xa4, 0xda, 0x03, 0x10,
-	0x83, 0xa9, 0x45, 0xda, 0x6d, 0xb9, 0x0e, 0x79, 0x76, 0x6d, 0x80, 0x2a, 0x41, 0x64, 0x94, 0x69,
-	0x20, 0xdc, 0x82, 0xe2, 0x90, 0x8b, 0x8b, 0x5c, 0x1f, 0x05, 0xd7, 0x02, 0x01, 0x58, 0xbe, 0x07,
-	0x05, 0x7e, 0x15, 0x43, 0x8f, 0xe4, 0xd9, 0x27, 0x4f, 0xda, 0x91, 0xab, 0x09, 0x1f, 0x26, 0xb7,
-	0xa1, 0x14, 0xba, 0x22, 0x40, 0x97, 0x01, 0x74, 0x6b, 0x30, 0xc0, 0x7a, 0x70, 0xf3, 0x25, 0xaa,
-	0xa1, 0x19, 0x54, 0x83, 0xa2, 0x7f, 0x89, 0xc0, 0x4d, 0x08, 0xc6, 0x72, 0x07, 0x60, 0x7a, 0x75,
-	0x80, 0xae, 0x02, 0xb8, 0xd8, 0x39, 0xc4, 0x4e, 0x70, 0x5e, 0xce, 0xce, 0xc4, 0x45, 0x36, 0xdb,
-	0xc5, 0x2f, 0x62, 0x47, 0xea, 0xe9, 0xd8, 0x91, 0xba, 0xfc, 0x73, 0x28, 0x85, 0x7e, 0xa5, 0xbe,
-	0x2e, 0x8f, 0xa3, 0x1b, 0xb0, 0xec, 0xe0, 0x81, 0x46, 0x9a, 0x8c, 0x3e, 0x47, 0xc8, 0x50, 0x84,
-	0x8a, 0x3f, 0xbd, 0xcd, 0x42, 0x43, 0x07, 0x98, 0x72, 0x0e, 0x1f, 0xf0, 0x0b, 0xb3, 0x07, 0xfc,
-	0x17, 0x41, 0x34, 0xf0, 0x80, 0xf4, 0x2e, 0xd8, 0xf1, 0x2d, 0x09, 0x26, 0x8e, 0x3b, 0xfe, 0xff,
-	0x95, 0x00, 0x45, 0xff, 0x1e, 0x10, 0x5d, 0x8f, 0x54, 0xa9, 0x33, 0x91, 0x4b, 0xc2, 0x50, 0xa1,
-	0xba, 0x05, 0x62, 0xf0, 0x46, 0x80, 0x47, 0x44, 0xc4, 0xb9, 0x53, 0xe8, 0xec, 0xd5, 0x53, 0xe6,
-	0x24, 0x57, 0x4f, 0xb7, 0xbf, 0x12, 0x40, 0x0c, 0xca, 0x23, 0x2a, 0x42, 0xb6, 0xf3, 0xf8, 0xd1,
-	0x23, 0x29, 0x85, 0x4a, 0x50, 0xd8, 0xd8, 0xde, 0x7e, 0xd4, 0x54, 0x3a, 0x92, 0x40, 0x06, 0xed,
-	0x4e, 0xaf, 0xb9, 0xd9, 0x54, 0xa5, 0x34, 0xc1, 0x79, 0xb4, 0xdd, 0xd9, 0x94, 0x32, 0x08, 0x20,
-	0xdf, 0xd8, 0x7e, 0xbc, 0xf1, 0xa8, 0x29, 0x65, 0xc9, 0x77, 0xb7, 0xa7, 0xb6, 0x3b, 0x9b, 0x52,
-	0x0e, 0x89, 0x90, 0xdb, 0x78, 0xda, 0x6b, 0x76, 0xa5, 0x3c, 0x41, 0x6e, 0x28, 0xbd, 0xa6, 0x54,
-	0x40, 0xcb, 0xec, 0xaf, 0xa6, 0xbf, 0xbd, 0xf1, 0x79, 0xb3, 0xde, 0x93, 0x8a, 0xa8, 0xc2, 0x1a,
-	0xf0, 0xbe, 0xa2, 0xaa, 0xca, 0x53, 0x49, 0x24, 0xa8, 0xbd, 0xe6, 0x4f, 0x7a, 0x12, 0xa0, 0x25,
-	0x10, 0xd5, 0x76, 0xbd, 0xd5, 0xa7, 0xc3, 0x12, 0xa1, 0xe4, 0xd2, 0xfb, 0xf5, 0x4e, 0x4f, 0x2a,
-	0xa3, 0x32, 0x14, 0x89, 0x06, 0x74, 0xb4, 0x44, 0xf8, 0x30, 0x2d, 0xe8, 0xb8, 0x72, 0xfb, 0x00,
-	0xca, 0xe1, 0x95, 0x44, 0x2b, 0x70, 0xa6, 0xb1, 0x5d, 0x7f, 0xbc, 0xd5, 0xec, 0xf4, 0xba, 0xfd,
-	0x7a, 0x4b, 0xe9, 0x6c, 0x36, 0x1b, 0x52, 0x2a, 0x3a, 0xfd, 0x44, 0xe9, 0xd5, 0x5b, 0xcd, 0x86,
-	0x24, 0xa0, 0xf3, 0x70, 0x76, 0x3a, 0xfd, 0xb8, 0xe3, 0x03, 0xd2, 0xe8, 0x1c, 0x48, 0x5b, 0xcd,
-	0x9e, 0xd2, 0x50, 0x7a, 0x4a, 0xc0, 0x25, 0xb3, 0xfe, 0xdb, 0x2c, 0xe4, 0x9f, 0xd2, 0x77, 0x20,
-	0xe8, 0x21, 0x54, 0xa2, 0x2f, 0x29, 0x10, 0xfb, 0x53, 0x4a, 0x7c, 0x96, 0x51, 0xbb, 0x90, 0x08,
-	0xe3, 0xb7, 0x7e, 0x29, 0xf4, 0x63, 0x90, 0xe2, 0x0f, 0x21, 0xd0, 0x45, 0xe6, 0xca, 0xe4, 0x77,
-	0x15, 0xb5, 0x4b, 0x47, 0x40, 0x03, 0x96, 0x44, 0xbf, 0xc8, 0xd3, 0x05, 0x5f, 0xbf, 0xa4, 0x77,
-	0x13, 0xbe, 0x7e, 0x89, 0x6f, 0x1d, 0x18, 0xb3, 0xe8, 0xb3, 0x01, 0xce, 0x2c, 0xf1, 0xcd, 0x02,
-	0x67, 0x96, 0xfc, 0xce, 0x40, 0x4e, 0xa1, 0x2d, 0xa8, 0x44, 0xef, 0xb6, 0x39, 0xb3, 0xc4, 0xd7,
-	0x02, 0x9c, 0x59, 0xf2, 0x65, 0xb8, 0x9c, 0xba, 0x27, 0xa0, 0x1f, 0x42, 0xd1, 0xbf, 0x25, 0x46,
-	0xec, 0x5a, 0x28, 0x76, 0x2d, 0x5d, 0x5b, 0x89, 0xcd, 0x86, 0xcd, 0x8a, 0x5e, 0xc4, 0x72, 0x4d,
-	0x12, 0xaf, 0x84, 0xb9, 0x26, 0x47, 0xdc, 0xdc, 0xa6, 0xd6, 0xbf, 0x20, 0x29, 0x7a, 0xec, 0x92,
-	0xb4, 0xf0, 0x10, 0x2a, 0xd1, 0x77, 0x3b, 0x9c, 0x6f, 0xe2, 0x6b, 0x21, 0xce, 0xf7, 0x88, 0x87,
-	0x3e, 0xa9, 0x0d, 0xe9, 0xf5, 0x9b, 0xcb, 0xc2, 0x5f, 0xdf, 0x5c, 0x16, 0xfe, 0xf5, 0xe6, 0xb2,
-	0xf0, 0x9b, 0x7f, 0x5f, 0x4e, 0xed, 0xe6, 0xe9, 0x1b, 0xa4, 0x0f, 0xff, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x43, 0x66, 0xb0, 0xc7, 0x97, 0x24, 0x00, 0x00,
+	// 2928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x5d, 0x8f, 0xe3, 0x56,
+	0x75, 0x9c, 0xef, 0x9c, 0xcc, 0x64, 0xbc, 0x77, 0x3e, 0xd6, 0xcd, 0xb4, 0xcb, 0xd4, 0xed, 0xd2,
+	0xed, 0x76, 0x35, 0xbb, 0x9a, 0xd2, 0x0f, 0x5a, 0x8a, 0xf0, 0x24, 0x61, 0x26, 0xed, 0x4e, 0x32,
+	0x38, 0x59, 0x96, 0x96, 0x07, 0xcb, 0x63, 0xdf, 0xdd, 0x71, 0x27, 0xb1, 0xb3, 0xb6, 0x33, 0x6a,
+	0x2a, 0xc4, 0x23, 0x42, 0x3c, 0xf3, 0xc0, 0x03, 0x4f, 0x80, 0xd4, 0x3f, 0x00, 0x42, 0x08, 0xa4,
+	0x3e, 0xf0, 0x40, 0x1f, 0x90, 0x0a, 0x8f, 0x08, 0x09, 0x50, 0x79, 0xe1, 0x19, 0xfe, 0x00, 0xba,
+	0x1f, 0x76, 0x6c, 0xc7, 0x99, 0x4c, 0x76, 0xfb, 0xb1, 0xf0, 0xe6, 0x7b, 0xcf, 0xe7, 0x3d, 0xe7,
+	0xf8, 0xdc, 0x73, 0xef, 0x3d, 0x20, 0xea, 0x43, 0xeb, 0xe6, 0xd8, 0x71, 0x4f, 0x2d, 0xbc, 0x33,
+	0x74, 0x1d, 0xdf, 0x41, 0x59, 0x7d, 0x68, 0xc9, 0x1a, 0x6c, 0xec, 0xb9, 0x8e, 0x6e, 0x1a, 0xba,
+	0xe7, 0x37, 0xcf, 0xb0, 0xed, 0xab, 0xf8, 0xc1, 0x08, 0x7b, 0x3e, 0x7a, 0x1a, 0x96, 0x87, 0xa3,
+	0xe3, 0xbe, 0xe5, 0x9d, 0x60, 0x57, 0xb3, 0x4c, 0x49, 0xd8, 0x16, 0xae, 0x2d, 0xab, 0x95, 0x70,
+	0xae, 0x65, 0xa2, 0x67, 0x20, 0x8f, 0x09, 0x89, 0x94, 0xd9, 0x16, 0xae, 0x55, 0x76, 0x57, 0x76,
+	0xf4, 0xa1, 0xb5, 0xd3, 0x70, 0x0c, 0xc6, 0x87, 0xc1, 0x64, 0x09, 0x36, 0x93, 0x02, 0xbc, 0xa1,
+	0x63, 0x7b, 0x58, 0xfe, 0x1e, 0x6c, 0x1c, 0x8d, 0xdc, 0xfb, 0x58, 0x19, 0xf9, 0x27, 0x75, 0xdd,
+	0x38, 0xc1, 0x81, 0xe8, 0x75, 0xc8, 0xfb, 0xce, 0x29, 0xb6, 0xa9, 0xcc, 0xb2, 0xca, 0x06, 0x48,
+	0x82, 0xa2, 0x37, 0x3a, 0x7e, 0x17, 0x1b, 0x4c, 0x5e, 0x59, 0x0d, 0x86, 0xe8, 0x45, 0x58, 0x36,
+	0x1d, 0x63, 0x34, 0xc0, 0xb6, 0xaf, 0x9d, 0xe2, 0xb1, 0x94, 0xa5, 0xea, 0x88, 0x81, 0x3a, 0x14,
+	0xf0, 0x16, 0x1e, 0xab, 0x15, 0x73, 0x32, 0x90, 0x5f, 0x87, 0xcd, 0xa4, 0x74, 0xa6, 0x17, 0x5b,
+	0xb9, 0x7b, 0x1f, 0x9b, 0x9a, 0xe1, 0x8c, 0x6c, 0x9f, 0x6a, 0x91, 0x57, 0x2b, 0x6c, 0xae, 0x4e,
+	0xa6, 0xe4, 0x97, 0x61, 0x43, 0x31, 0x7c, 0xeb, 0x4c, 0xf7, 0x71, 0xbd, 0x6f, 0x45, 0xac, 0xf6,
+	0x14, 0x80, 0xd1, 0xb7, 0x02, 0x45, 0x98, 0xfe, 0x65, 0x36, 0x43, 0x84, 0xf6, 0x60, 0x33, 0x49,
+	0xc7, 0x85, 0x9e, 0x4f, 0x88, 0xb6, 0x80, 0x0f, 0x88, 0x2b, 0x32, 0xd4, 0x15, 0x25, 0x36, 0xd1,
+	0x32, 0xe5, 0x97, 0xe1, 0x72, 0x03, 0xeb, 0xa9, 0xfa, 0xc4, 0xe8, 0x84, 0x04, 0xdd, 0x2b, 0x20,
+	0x4d, 0xd3, 0x71, 0x7d, 0xce, 0x25, 0xfc, 0x95, 0x00, 0x1b, 0x8a, 0xef, 0xeb, 0xc6, 0x49, 0x60,
+	0xde, 0x8b, 0xc8, 0x43, 0xb7, 0xa0, 0x62, 0x9c, 0xe8, 0xf6, 0x7d, 0xac, 0x0d, 0x75, 0xe3, 0x94,
+	0x47, 0xcd, 0x2a, 0x75, 0x53, 0x9d, 0xce, 0x1f, 0xe9, 0xc6, 0xa9, 0x0a, 0x46, 0xf8, 0x8d, 0x0e,
+	0x61, 0xc3, 0xb3, 0xf5, 0xa1, 0x77, 0xe2, 0xf8, 0x9a, 0xe1, 0x0c, 0x86, 0x2e, 0xf6, 0x3c, 0xcb,
+	0xb1, 0x3d, 0x29, 0xbb, 0x9d, 0xbd, 0x56, 0xdd, 0x95, 0x28, 0x6d, 0x97, 0x63, 0xd4, 0x27, 0x08,
+	0xea, 0xba, 0x37, 0x3d, 0xe9, 0xc9, 0xf7, 0x61, 0x33, 0xa9, 0xf6, 0x05, 0x96, 0xbb, 0xb8, 0xde,
+	0xd4, 0x40, 0x0d, 0xfc, 0xbf, 0x67, 0x20, 0x0b, 0x36, 0x1b, 0x38, 0xd5, 0x40, 0x73, 0xe2, 0x73,
+	0x71, 0x13, 0xfd, 0x54, 0x80, 0x8d, 0xbb, 0xba, 0x3f, 0x11, 0xe5, 0x05, 0x26, 0x7a, 0x06, 0x0a,
+	0x8c, 0x31, 0x15, 0x53, 0xd9, 0xad, 0x30, 0x36, 0x74, 0x4a, 0xe5, 0x20, 0xf4, 0x12, 0xac, 0x44,
+	0xff, 0x79, 0x4f, 0xca, 0x6c, 0x67, 0x53, 0x7f, 0xfa, 0xe5, 0xc8, 0x4f, 0xef, 0xa1, 0xe7, 0x60,
+	0xd5, 0xb2, 0x8d, 0xfe, 0xc8, 0xc4, 0x1a, 0xd3, 0xc5, 0xa3, 0xd9, 0xa2, 0xa4, 0x56, 0xf9, 0x34,
+	0xd3, 0xd6, 0x93, 0xff, 0x95, 0x81, 0xcd, 0xa4, 0x7a, 0xdc, 0x14, 0x3d, 0xa8, 0x5a, 0xb6, 0xe5,
+	0x5b, 0x7a, 0xdf, 0x7a, 0x5f, 0xf7, 0x2d, 0xc7, 0xe6, 0x7a, 0x5e, 0xa7, 0xb2, 0xd3, 0x89, 0x76,
+	0x5a, 0x31, 0x8a, 0x83, 0x25, 0x35, 0xc1, 0x03, 0x5d, 0x3d, 0x2f, 0x99, 0x1e, 0x2c, 0xf1, 0x74,
+	0x5a, 0xfb, 0x48, 0x80, 0x6a, 0x9c, 0x17, 0xba, 0x07, 0xe2, 0x10, 0x63, 0xd7, 0xd3, 0x06, 0xfa,
+	0x50, 0x3b, 0x1e, 0x6b, 0xa6, 0x63, 0x48, 0x02, 0xb5, 0xc6, 0x1b, 0x17, 0xd7, 0x68, 0xe7, 0x88,
+	0xb0, 0x38, 0xd4, 0x87, 0x7b, 0x63, 0x22, 0xd4, 0xf6, 0xdd, 0xb1, 0xba, 0x32, 0x8c, 0xce, 0xd5,
+	0xda, 0x80, 0xa6, 0x91, 0x90, 0x08, 0xd9, 0x49, 0x44, 0x90, 0x4f, 0x24, 0x43, 0xfe, 0x4c, 0xef,
+	0x8f, 0x30, 0x5f, 0xc9, 0x72, 0xc4, 0x7d, 0x9e, 0xca, 0x40, 0xaf, 0x65, 0x5e, 0x15, 0xf6, 0x0a,
+	0x90, 0x3b, 0x76, 0xcc, 0xb1, 0xfc, 0x1f, 0x01, 0xb6, 0xe2, 0x3a, 0x76, 0x7d, 0x17, 0xeb, 0x83,
+	0x85, 0xe2, 0xe1, 0x1b, 0xb0, 0xa6, 0x9b, 0x26, 0x36, 0xb5, 0x8b, 0x45, 0xc5, 0x25, 0x8a, 0xdc,
+	0x88, 0x86, 0x46, 0x03, 0x36, 0x5c, 0x3c, 0x70, 0xce, 0xa6, 0x78, 0x64, 0x67, 0xf0, 0x58, 0xe3,
+	0xe8, 0x8d, 0x39, 0x01, 0x96, 0x4b, 0x0d, 0xb0, 0x3f, 0x0a, 0xb0, 0x7a, 0x34, 0xf2, 0x4e, 0x8e,
+	0x46, 0xfd, 0xfe, 0x67, 0x94, 0x1c, 0xb6, 0xa0, 0x3c, 0x1c, 0x79, 0x27, 0x9a, 0x63, 0xf7, 0xc7,
+	0x3c, 0xcc, 0x4b, 0x64, 0xa2, 0x63, 0xf7, 0xc7, 0xb3, 0x33, 0x47, 0xee, 0xa1, 0x32, 0x87, 0x0e,
+	0xe2, 0x64, 0x35, 0x9f, 0x4d, 0x52, 0xf5, 0x60, 0xe3, 0xce, 0xd0, 0xd4, 0x7d, 0x7c, 0x88, 0x7d,
+	0xdd, 0xd4, 0x7d, 0xfd, 0x73, 0x48, 0x18, 0xa4, 0x7c, 0x49, 0x0a, 0xe5, 0xe5, 0xcb, 0x6f, 0x04,
+	0x58, 0xff, 0x26, 0xf6, 0xa7, 0x53, 0x7c, 0xb2, 0x1c, 0x11, 0x2e, 0x50, 0x8e, 0xcc, 0x76, 0x47,
+	0xe6, 0x61, 0xdc, 0x41, 0xd2, 0xb5, 0x87, 0xdd, 0x33, 0xec, 0x6a, 0x1e, 0x7e, 0x40, 0x7d, 0x9f,
+	0x53, 0xcb, 0x6c, 0xa6, 0x8b, 0x1f, 0xc8, 0x2d, 0xd8, 0x48, 0xa8, 0xce, 0x5d, 0x96, 0xf0, 0x8a,
+	0x30, 0xdf, 0x2b, 0x3f, 0x14, 0x40, 0x0c, 0x0b, 0xbc, 0xcf, 0x23, 0x85, 0x4b, 0x50, 0x1c, 0xea,
+	0xe3, 0xbe, 0xa3, 0x9b, 0x74, 0x5d, 0xcb, 0x6a, 0x30, 0x94, 0xd7, 0xe0, 0x52, 0x44, 0x13, 0xee,
+	0xa6, 0x3e, 0xac, 0xd1, 0xe4, 0xc2, 0xff, 0xbb, 0x40, 0xc3, 0x2b, 0x00, 0x86, 0xd3, 0xef, 0x63,
+	0x23, 0x4c, 0xe0, 0x65, 0x35, 0x32, 0x83, 0x36, 0xa1, 0x60, 0x8c, 0x5c, 0xcf, 0x71, 0x79, 0xb1,
+	0xc9, 0x47, 0xd4, 0xb0, 0xbe, 0xee, 0xfa, 0x9a, 0x6f, 0x0d, 0x30, 0x55, 0x20, 0xab, 0x96, 0xe9,
+	0x4c, 0xcf, 0x1a, 0x60, 0xf9, 0x03, 0x01, 0xd6, 0xe3, 0xe2, 0xb8, 0x61, 0x1f, 0x2a, 0x28, 0xe2,
+	0x5e, 0xcc, 0x24, 0xbc, 0x48, 0xad, 0x4c, 0xc5, 0x48, 0xd9, 0xa8, 0x95, 0xe9, 0x94, 0xca, 0x41,
+	0x91, 0x85, 0xe4, 0xa2, 0x0b, 0x91, 0xff, 0x2d, 0x00, 0x4c, 0x5c, 0xfa, 0x70, 0xfa, 0xdd, 0x04,
+	0x30, 0x4e, 0xb0, 0x71, 0x3a, 0x74, 0xac, 0x70, 0xe3, 0x0a, 0x82, 0x25, 0x98, 0x56, 0x23, 0x28,
+	0xa8, 0x06, 0xa5, 0x20, 0x5c, 0xb9, 0xf3, 0xc2, 0x31, 0xba, 0x0a, 0xc5, 0x49, 0xc6, 0xcc, 0x26,
+	0x97, 0x13, 0xc0, 0xd0, 0xeb, 0x70, 0x69, 0x60, 0xd9, 0x9a, 0x37, 0xb6, 0x0d, 0x6c, 0x6a, 0xbe,
+	0x65, 0x9c, 0x62, 0x5f, 0xca, 0x47, 0x44, 0x13, 0x3f, 0xf4, 0xe8, 0xb4, 0xba, 0x3a, 0xb0, 0xec,
+	0x2e, 0x45, 0x64, 0x13, 0xf2, 0x03, 0x28, 0x30, 0x7e, 0xe8, 0x29, 0xc8, 0xf0, 0xa4, 0x14, 0xec,
+	0xb5, 0x0c, 0xd0, 0x6a, 0xa8, 0x19, 0xcb, 0x24, 0x41, 0x36, 0xc0, 0x9e, 0xa7, 0xdf, 0xc7, 0xc1,
+	0x61, 0x83, 0x0f, 0xd1, 0x0e, 0x80, 0x33, 0xc4, 0x2e, 0xdd, 0x34, 0x83, 0xbd, 0xa1, 0x4a, 0x19,
+	0x74, 0x82, 0x69, 0x35, 0x82, 0x21, 0x1f, 0x43, 0x29, 0xe0, 0x1c, 0x29, 0xa2, 0x88, 0x3f, 0x89,
+	0xf0, 0x95, 0xa0, 0x88, 0x22, 0xfe, 0x7c, 0x12, 0x8a, 0x7d, 0x7d, 0x30, 0x74, 0x5c, 0x66, 0xcb,
+	0xdc, 0x5e, 0xe6, 0x96, 0xa0, 0x06, 0x53, 0xe8, 0x09, 0x28, 0xe9, 0x86, 0xef, 0xd0, 0xc3, 0x18,
+	0x0f, 0x7c, 0x3a, 0x6e, 0x99, 0xf2, 0x47, 0x9b, 0x50, 0x0e, 0xa5, 0xa3, 0x2f, 0x43, 0xd6, 0xc3,
+	0xc1, 0x9f, 0x87, 0xe2, 0xaa, 0xed, 0x74, 0x31, 0x29, 0x26, 0x08, 0x02, 0xc1, 0xd3, 0x4d, 0x53,
+	0xca, 0xa4, 0xe2, 0x29, 0xa6, 0x49, 0xf0, 0x74, 0xd3, 0x44, 0xcf, 0x43, 0x8e, 0xec, 0x73, 0x3c,
+	0xc8, 0xd6, 0x12, 0x88, 0x87, 0xce, 0x19, 0x3e, 0x58, 0x52, 0x29, 0x0a, 0xba, 0x09, 0x05, 0xb6,
+	0x29, 0xd2, 0x60, 0xab, 0xec, 0x6e, 0x24, 0x90, 0x55, 0x0a, 0x3c, 0x58, 0x52, 0x39, 0x1a, 0xe1,
+	0x8d, 0x4d, 0x2b, 0x70, 0x60, 0x92, 0x77, 0xd3, 0xb4, 0x88, 0xb6, 0x14, 0x85, 0xf0, 0xf6, 0x30,
+	0xf9, 0x3d, 0xa5, 0x42, 0x2a, 0xef, 0x2e, 0x05, 0x12, 0xde, 0x0c, 0x0d, 0xbd, 0x0c, 0x65, 0xd7,
+	0x32, 0x4e, 0x34, 0x2a, 0xa0, 0x48, 0x69, 0x2e, 0x27, 0xf5, 0xb1, 0x8c, 0x13, 0x2e, 0xa4, 0xe4,
+	0xf2, 0x6f, 0x74, 0x03, 0xf2, 0x9e, 0x3f, 0xee, 0x63, 0xa9, 0x44, 0x69, 0xd6, 0x93, 0x72, 0x08,
+	0x8c, 0x14, 0x64, 0x14, 0x09, 0xbd, 0x04, 0x25, 0xcb, 0x36, 0x5c, 0xac, 0x7b, 0x58, 0x2a, 0xa7,
+	0x0a, 0x69, 0x71, 0x30, 0x11, 0x12, 0xa0, 0xd6, 0x7e, 0x29, 0x40, 0xb6, 0x8b, 0x7d, 0x12, 0xce,
+	0x43, 0xdd, 0x25, 0x21, 0x41, 0x00, 0x3e, 0x36, 0x35, 0xdd, 0x97, 0x84, 0x19, 0xe1, 0xcc, 0x30,
+	0xeb, 0x0c, 0x51, 0xf1, 0x83, 0xda, 0x2b, 0x33, 0xa9, 0xbd, 0x6e, 0x04, 0xb5, 0x17, 0x73, 0xd6,
+	0x26, 0x65, 0xf1, 0x66, 0xb7, 0xd3, 0x6e, 0xf6, 0x31, 0xf9, 0x6b, 0xbb, 0xd6, 0x60, 0xd8, 0xc7,
+	0xbc, 0x0a, 0x23, 0xd9, 0x1e, 0xbf, 0x87, 0x8d, 0x11, 0x17, 0x9b, 0x4b, 0x17, 0x0b, 0x01, 0x8e,
+	0xe2, 0xd7, 0xfe, 0x2a, 0x40, 0x56, 0x31, 0xcd, 0x47, 0x53, 0xfb, 0x15, 0x58, 0x1d, 0xba, 0xf8,
+	0x2c, 0x4a, 0x9a, 0x49, 0x27, 0x5d, 0x21, 0x78, 0x13, 0xc2, 0xcf, 0x7a, 0x75, 0x7f, 0x13, 0x20,
+	0x47, 0xe2, 0xf9, 0x0b, 0x5a, 0xde, 0x0e, 0x40, 0x84, 0x26, 0x9b, 0x4e, 0x53, 0x36, 0x42, 0xfc,
+	0xc5, 0x17, 0xf8, 0x81, 0x00, 0x05, 0xf6, 0x0f, 0x3e, 0xda, 0x12, 0xe3, 0x9a, 0x66, 0x16, 0xd5,
+	0x34, 0x3b, 0x5f, 0xd3, 0x1f, 0x67, 0x21, 0x47, 0xff, 0xc6, 0x47, 0xd2, 0xf3, 0x59, 0xc8, 0xdd,
+	0x73, 0x9d, 0x81, 0x94, 0x89, 0xec, 0x66, 0x3d, 0xfc, 0x9e, 0xdf, 0x76, 0x4c, 0x7c, 0xe4, 0x78,
+	0x2a, 0x85, 0xa2, 0x6d, 0xc8, 0xf8, 0x8e, 0x94, 0x9d, 0x81, 0x93, 0xf1, 0x1d, 0x74, 0x0c, 0x97,
+	0x27, 0xd2, 0x83, 0x73, 0x16, 0xcd, 0xbe, 0x7c, 0xaf, 0xba, 0x91, 0x92, 0xb9, 0x76, 0x42, 0x3d,
+	0xe8, 0x89, 0x49, 0x21, 0xe8, 0xec, 0x60, 0xb5, 0x66, 0x4c, 0x43, 0xc8, 0x96, 0x63, 0x38, 0xb6,
+	0x8f, 0x6d, 0x96, 0x0d, 0xcb, 0x6a, 0x30, 0x4c, 0x5a, 0xaf, 0x30, 0xdf, 0x7a, 0x77, 0x41, 0x9a,
+	0x25, 0x3c, 0xe5, 0xc0, 0x76, 0x35, 0x7e, 0x60, 0x9b, 0xe2, 0x3c, 0x39, 0xb3, 0xd5, 0x3e, 0x14,
+	0xa0, 0xc0, 0x12, 0xed, 0xe3, 0xe1, 0x98, 0xc5, 0x7f, 0x81, 0x5f, 0xe4, 0xa0, 0x14, 0xa4, 0xfd,
+	0xc7, 0x63, 0x0d, 0xf7, 0xe6, 0x05, 0xd7, 0xad, 0x19, 0xbb, 0xd6, 0xa7, 0x16, 0x60, 0xfb, 0x00,
+	0xba, 0xef, 0xbb, 0xd6, 0xf1, 0xc8, 0xc7, 0x9e, 0x54, 0xa0, 0x42, 0x9f, 0x9b, 0x25, 0x54, 0x09,
+	0x31, 0x99, 0xac, 0x08, 0x69, 0xd2, 0x1d, 0xc5, 0x2f, 0x30, 0x52, 0xdf, 0x80, 0xd5, 0x84, 0xa6,
+	0x29, 0xfc, 0xd6, 0xa3, 0xfc, 0xca, 0x51, 0xf2, 0xdf, 0x67, 0x20, 0x4f, 0x77, 0xfa, 0xc7, 0x23,
+	0x46, 0x1a, 0x31, 0x0f, 0xb1, 0xb0, 0x78, 0x36, 0xad, 0x30, 0x59, 0xc4, 0x3d, 0xf9, 0xf9, 0xee,
+	0x79, 0x44, 0x2b, 0x7e, 0x20, 0x40, 0x29, 0x28, 0x7f, 0x1e, 0xcd, 0x90, 0x37, 0xe2, 0x9e, 0x5f,
+	0x6c, 0xeb, 0x9f, 0xbf, 0xdf, 0x84, 0x97, 0x51, 0x7f, 0x11, 0xe0, 0xd2, 0x14, 0xdb, 0xc4, 0x7e,
+	0x27, 0xcc, 0xdd, 0xef, 0xae, 0x43, 0x89, 0xdd, 0x24, 0xcd, 0xde, 0x1d, 0x8b, 0x14, 0x81, 0xed,
+	0xa5, 0xc1, 0xbd, 0xd3, 0x39, 0xbb, 0x3e, 0x47, 0x51, 0x7c, 0x24, 0x43, 0xce, 0x1f, 0x0f, 0x59,
+	0x85, 0x5d, 0xe5, 0x47, 0x8f, 0x6f, 0x93, 0x55, 0xf7, 0xc6, 0x43, 0xac, 0x52, 0xd8, 0xc4, 0x23,
+	0x79, 0x7a, 0x50, 0x60, 0x03, 0xf9, 0x47, 0xcb, 0x50, 0x89, 0xac, 0x0d, 0x7d, 0x1d, 0x2a, 0xef,
+	0x7a, 0x8e, 0xad, 0x39, 0xec, 0x55, 0x85, 0x2d, 0x6b, 0x2b, 0x69, 0x59, 0xfa, 0xdd, 0xa1, 0x28,
+	0x07, 0x4b, 0x2a, 0x10, 0x0a, 0x36, 0x42, 0xaf, 0x03, 0x1d, 0x69, 0xba, 0xeb, 0xea, 0x63, 0xbe,
+	0xce, 0x5a, 0x2a, 0xb9, 0x42, 0x30, 0x0e, 0x96, 0xd4, 0x32, 0xc1, 0xa7, 0x03, 0xf4, 0x1a, 0x94,
+	0x87, 0xae, 0x35, 0xb0, 0x7c, 0x2b, 0x3c, 0x5a, 0x4c, 0xd3, 0x1e, 0x05, 0x18, 0x84, 0x36, 0x44,
+	0x47, 0x2f, 0x40, 0xce, 0xc7, 0xef, 0xf9, 0xb1, 0x43, 0x46, 0x94, 0x8c, 0xfc, 0x3d, 0xe4, 0xdc,
+	0x40, 0x90, 0xd0, 0xab, 0xfc, 0x18, 0x40, 0x29, 0x58, 0xc8, 0x3f, 0x31, 0x45, 0x41, 0xb2, 0x1b,
+	0xa7, 0x2a, 0xb9, 0xfc, 0x1b, 0x7d, 0x85, 0x24, 0xcc, 0x91, 0xed, 0x63, 0x97, 0xef, 0xb9, 0xd2,
+	0x14, 0x5d, 0x9d, 0xc1, 0x0f, 0x96, 0xd4, 0x00, 0xb5, 0xf6, 0x3b, 0x01, 0x60, 0x62, 0x32, 0x72,
+	0x1b, 0x6a, 0x3b, 0x26, 0xf6, 0xf8, 0x95, 0x2c, 0xbb, 0x0d, 0x55, 0x0f, 0x7a, 0xe4, 0xef, 0x56,
+	0x19, 0x68, 0xe1, 0x72, 0x2a, 0x1a, 0x5e, 0xd9, 0x85, 0xc2, 0x2b, 0x37, 0x2f, 0xbc, 0x6a, 0xbf,
+	0x15, 0xa0, 0x1c, 0xba, 0x6c, 0x86, 0xf6, 0xfb, 0xca, 0xe3, 0xaa, 0xfd, 0x9f, 0x05, 0x28, 0x87,
+	0x41, 0x13, 0xfe, 0x2a, 0xc2, 0x45, 0x7e, 0x95, 0x4c, 0xe4, 0x57, 0x59, 0xb8, 0x14, 0x8f, 0xae,
+	0x29, 0xb7, 0xd0, 0x9a, 0xf2, 0x73, 0xd7, 0xf4, 0x6b, 0x01, 0x72, 0x34, 0x1e, 0x9f, 0x89, 0x3b,
+	0x63, 0x25, 0xb6, 0x53, 0x3c, 0x8e, 0xde, 0xf8, 0x50, 0x60, 0xb5, 0x16, 0xd5, 0xfe, 0xb9, 0xb8,
+	0xf6, 0x97, 0x58, 0x28, 0x71, 0xe8, 0xe3, 0xba, 0x82, 0x8f, 0x05, 0x28, 0xf2, 0x7f, 0xfc, 0xff,
+	0x23, 0x9a, 0xc8, 0x46, 0xb7, 0x47, 0x36, 0xba, 0x7d, 0x28, 0xf2, 0x2c, 0x94, 0xb2, 0xa3, 0x5f,
+	0x87, 0x22, 0x66, 0x19, 0x2e, 0x56, 0xb9, 0x44, 0x32, 0x9f, 0x1a, 0x20, 0xc8, 0x77, 0xa1, 0xc8,
+	0x13, 0x02, 0xda, 0x86, 0x9c, 0x4d, 0xb2, 0xac, 0x10, 0x79, 0xf8, 0xe1, 0x30, 0x95, 0x42, 0x16,
+	0x62, 0xfc, 0x33, 0x01, 0x4a, 0x41, 0x6c, 0xa0, 0x2f, 0x45, 0xee, 0xeb, 0x56, 0x63, 0x81, 0xcf,
+	0x6f, 0xec, 0x52, 0x8b, 0x90, 0x85, 0x37, 0xd7, 0x9b, 0x50, 0xb1, 0x6c, 0x4f, 0xa3, 0xe7, 0x77,
+	0xcb, 0x94, 0x72, 0xe9, 0xf2, 0xca, 0x96, 0xed, 0x1d, 0xb9, 0xf8, 0xac, 0x65, 0xca, 0xef, 0x82,
+	0x18, 0x8d, 0x61, 0x52, 0x2c, 0x5d, 0xb4, 0x42, 0x22, 0xca, 0x8d, 0xe8, 0xdb, 0xc2, 0xb9, 0xca,
+	0x71, 0x14, 0xc5, 0x97, 0x3f, 0xcc, 0xc0, 0x72, 0x54, 0xd8, 0x7c, 0xa3, 0x28, 0xb1, 0xb2, 0x91,
+	0xdd, 0xaf, 0x3f, 0x3d, 0xf5, 0xe3, 0x9d, 0x5b, 0x33, 0xae, 0x47, 0xef, 0x5c, 0x66, 0xd8, 0x35,
+	0xb7, 0xa8, 0x5d, 0xf3, 0xf3, 0xec, 0x5a, 0xeb, 0x5d, 0xa4, 0xf0, 0x7c, 0x21, 0x5e, 0x14, 0x6e,
+	0x4c, 0xad, 0x8c, 0xb0, 0x88, 0xd4, 0xa3, 0x72, 0x0f, 0x60, 0x22, 0x6e, 0xe1, 0xaa, 0x6e, 0x13,
+	0x0a, 0xce, 0xbd, 0x7b, 0x1e, 0x66, 0xb1, 0x9b, 0x57, 0xf9, 0x48, 0xfe, 0x81, 0x00, 0xa5, 0xe0,
+	0x79, 0x88, 0xd8, 0xcb, 0xe8, 0x3b, 0xfc, 0xed, 0x24, 0xaf, 0xb2, 0x01, 0xa9, 0x58, 0x08, 0x94,
+	0xbb, 0x80, 0xdd, 0x10, 0x06, 0x24, 0x3b, 0x0d, 0xdd, 0xd7, 0x99, 0xe1, 0x29, 0x52, 0xed, 0x15,
+	0x28, 0x87, 0x53, 0x8b, 0x94, 0xdb, 0x72, 0x1d, 0x0a, 0xec, 0x8d, 0x05, 0x55, 0xc3, 0xc8, 0x58,
+	0xa6, 0x81, 0xf0, 0x3c, 0x94, 0x06, 0x5c, 0x5c, 0xec, 0x81, 0x39, 0xd0, 0x41, 0x0d, 0xc1, 0xf2,
+	0x2d, 0x28, 0x32, 0x26, 0x1e, 0xbd, 0x92, 0x67, 0x9f, 0x92, 0x10, 0xbd, 0x92, 0xa7, 0x73, 0x6a,
+	0x00, 0x93, 0x5b, 0x50, 0x89, 0x3c, 0x11, 0xcc, 0x7d, 0x5a, 0xa9, 0x41, 0x29, 0x78, 0x44, 0xe0,
+	0x4b, 0x08, 0xc7, 0x72, 0x9b, 0x3c, 0x4a, 0x84, 0xcf, 0x05, 0x4f, 0xc7, 0xde, 0x3f, 0x84, 0xf0,
+	0x4e, 0x3c, 0xf2, 0x06, 0x12, 0xbf, 0x52, 0xcf, 0x24, 0xae, 0xd4, 0xe5, 0xef, 0x43, 0x25, 0x72,
+	0x94, 0xfa, 0xb4, 0x3c, 0x4e, 0x5e, 0x79, 0x5d, 0xdc, 0xd7, 0x49, 0x91, 0xa1, 0x71, 0x84, 0x2c,
+	0x45, 0xa8, 0x06, 0xd3, 0x1d, 0x16, 0x1a, 0x06, 0xc0, 0x84, 0x73, 0xf4, 0x82, 0x5f, 0x98, 0xbe,
+	0xe0, 0x7f, 0x12, 0xca, 0x26, 0xee, 0x93, 0xda, 0x05, 0xbb, 0xc1, 0x4a, 0xc2, 0x89, 0xf3, 0xae,
+	0xff, 0xff, 0x2e, 0x40, 0x29, 0xe8, 0x14, 0x40, 0x57, 0x63, 0xbb, 0xd4, 0xa5, 0x58, 0x1b, 0x41,
+	0x64, 0xa3, 0x7a, 0x1e, 0xca, 0x61, 0x2b, 0x17, 0x8f, 0x88, 0x98, 0x73, 0x27, 0xd0, 0xe9, 0x77,
+	0xba, 0xec, 0xa2, 0xef, 0x74, 0xb9, 0xd8, 0x3b, 0x5d, 0xf2, 0x91, 0x31, 0x3f, 0xf7, 0x91, 0xf1,
+	0xfa, 0xc7, 0x02, 0x94, 0xc3, 0xad, 0x16, 0x95, 0x20, 0xd7, 0xbe, 0x73, 0xfb, 0xb6, 0xb8, 0x84,
+	0x2a, 0x50, 0xdc, 0xeb, 0x74, 0x6e, 0x37, 0x95, 0xb6, 0x28, 0x90, 0x41, 0xab, 0xdd, 0x6b, 0xee,
+	0x37, 0x55, 0x31, 0x43, 0x70, 0x6e, 0x77, 0xda, 0xfb, 0x62, 0x16, 0x01, 0x14, 0x1a, 0x9d, 0x3b,
+	0x7b, 0xb7, 0x9b, 0x62, 0x8e, 0x7c, 0x77, 0x7b, 0x6a, 0xab, 0xbd, 0x2f, 0xe6, 0x51, 0x19, 0xf2,
+	0x7b, 0x6f, 0xf7, 0x9a, 0x5d, 0xb1, 0x40, 0x90, 0x1b, 0x4a, 0xaf, 0x29, 0x16, 0xd1, 0x2a, 0x3b,
+	0x21, 0x69, 0x9d, 0xbd, 0x37, 0x9b, 0xf5, 0x9e, 0x58, 0x42, 0x55, 0x56, 0xcc, 0x6b, 0x8a, 0xaa,
+	0x2a, 0x6f, 0x8b, 0x65, 0x82, 0xda, 0x6b, 0x7e, 0xa7, 0x27, 0x02, 0x5a, 0x81, 0xb2, 0xda, 0xaa,
+	0x1f, 0x68, 0x74, 0x58, 0x21, 0x94, 0x5c, 0xba, 0x56, 0x6f, 0xf7, 0xc4, 0x65, 0xb4, 0x0c, 0x25,
+	0xa2, 0x01, 0x1d, 0xad, 0x10, 0x3e, 0x4c, 0x0b, 0x3a, 0xae, 0x5e, 0x7f, 0x03, 0xd6, 0x52, 0x5e,
+	0x73, 0x11, 0x82, 0x6a, 0xbb, 0xa3, 0xd5, 0x3b, 0x87, 0x47, 0x6a, 0xb3, 0xdb, 0x6d, 0x75, 0xda,
+	0xe2, 0x12, 0x11, 0xb9, 0xff, 0x4e, 0xeb, 0x48, 0x14, 0xc8, 0xd7, 0x3b, 0xdd, 0x5e, 0x43, 0xcc,
+	0x5c, 0x7f, 0x1f, 0x96, 0xa3, 0x4e, 0x45, 0x1b, 0x70, 0xa9, 0xd1, 0xa9, 0xdf, 0x39, 0x6c, 0xb6,
+	0x7b, 0x5d, 0xad, 0x7e, 0xa0, 0xb4, 0xf7, 0x9b, 0x0d, 0x71, 0x29, 0x3e, 0x7d, 0x57, 0xe9, 0xd5,
+	0x0f, 0x9a, 0x0d, 0x51, 0x40, 0x97, 0x61, 0x6d, 0x32, 0x7d, 0xa7, 0x1d, 0x00, 0x32, 0x68, 0x1d,
+	0xc4, 0xc3, 0x66, 0x4f, 0x69, 0x28, 0x3d, 0x25, 0xe4, 0x92, 0x25, 0x2b, 0xdd, 0x53, 0x3b, 0x4a,
+	0xa3, 0xae, 0x74, 0x7b, 0x62, 0x6e, 0xf7, 0x0f, 0x05, 0x28, 0xbc, 0x4d, 0x1b, 0x09, 0xd1, 0x5b,
+	0x50, 0x8d, 0xf7, 0xb3, 0x21, 0x76, 0x86, 0x4b, 0x6d, 0x8e, 0xab, 0x6d, 0xa5, 0xc2, 0xf8, 0x3b,
+	0xed, 0x12, 0xfa, 0x16, 0x88, 0xc9, 0x76, 0x34, 0xf4, 0x24, 0x0b, 0xb2, 0xf4, 0xee, 0xb6, 0xda,
+	0x53, 0x33, 0xa0, 0x21, 0x4b, 0xa2, 0x5f, 0xac, 0xe1, 0x2b, 0xd0, 0x2f, 0xad, 0x79, 0xad, 0xb6,
+	0x95, 0x0a, 0x8b, 0x32, 0x6b, 0xe0, 0x14, 0x66, 0x0d, 0x3c, 0x9b, 0x59, 0x7a, 0x37, 0x95, 0xbc,
+	0x84, 0x0e, 0xa1, 0x1a, 0xef, 0x79, 0xe1, 0xcc, 0x52, 0x5b, 0xa2, 0x6a, 0x5b, 0xa9, 0xb0, 0x80,
+	0xd9, 0x2d, 0x01, 0x7d, 0x17, 0xd6, 0xe3, 0x50, 0xd6, 0x42, 0x83, 0xb6, 0x53, 0x08, 0x63, 0xdd,
+	0x35, 0x73, 0x58, 0x5f, 0x13, 0x6e, 0x09, 0xe8, 0xab, 0x50, 0x0a, 0x7a, 0x3b, 0x10, 0x7b, 0x0d,
+	0x4b, 0x34, 0xae, 0xd4, 0x36, 0x12, 0xb3, 0x51, 0x9b, 0xc5, 0xdb, 0x27, 0xf8, 0x32, 0x53, 0x1b,
+	0x39, 0x6a, 0x5b, 0xa9, 0xb0, 0x90, 0xd9, 0x01, 0xac, 0xc4, 0xba, 0x16, 0x10, 0x3b, 0xc7, 0xa7,
+	0x35, 0x61, 0xd4, 0x6a, 0x69, 0xa0, 0x90, 0xd3, 0xd7, 0xa0, 0x1c, 0x76, 0x0a, 0x20, 0xa6, 0x7c,
+	0xb2, 0x87, 0xa1, 0xb6, 0x99, 0x9c, 0x0e, 0xa9, 0xf7, 0x61, 0x39, 0xfa, 0xc6, 0x8f, 0xa4, 0x89,
+	0x09, 0xe3, 0x5d, 0x06, 0xb5, 0x27, 0x52, 0x20, 0x13, 0xaf, 0xed, 0xfe, 0x9c, 0x9c, 0x2e, 0xfa,
+	0x23, 0x8f, 0xe4, 0xf7, 0xb7, 0xa0, 0x1a, 0xef, 0x93, 0xe5, 0x96, 0x4a, 0xed, 0xce, 0xad, 0x6d,
+	0xa5, 0xc2, 0xa2, 0x66, 0x8f, 0x37, 0xb7, 0x72, 0x66, 0xa9, 0xfd, 0xb6, 0xb5, 0xad, 0x54, 0x58,
+	0xc0, 0x6c, 0xb7, 0x07, 0x79, 0xc5, 0x1c, 0x58, 0xf6, 0xa7, 0xca, 0x75, 0x4f, 0xfc, 0xe8, 0x93,
+	0x2b, 0xc2, 0x9f, 0x3e, 0xb9, 0x22, 0xfc, 0xe3, 0x93, 0x2b, 0xc2, 0x4f, 0xfe, 0x79, 0x65, 0xe9,
+	0xb8, 0x40, 0xdb, 0x92, 0x5f, 0xfc, 0xef, 0x00, 0x55, 0xe6, 0x42, 0x84, 0xaa, 0x2c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3814,8 +4469,12 @@ type YorkieClient interface {
 	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
 	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
 	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error)
+	WatchDocumentsStream(ctx context.Context, opts ...grpc.CallOption) (Yorkie_WatchDocumentsStreamClient, error)
 	PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error)
 	UpdateMetadata(ctx context.Context, in *UpdateMetadataRequest, opts ...grpc.CallOption) (*UpdateMetadataResponse, error)
+	FetchDocument(ctx context.Context, in *FetchDocumentRequest, opts ...grpc.CallOption) (*FetchDocumentResponse, error)
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (Yorkie_WatchChangesClient, error)
 }
 
 type yorkieClient struct {
@@ -3894,6 +4553,37 @@ func (x *yorkieWatchDocumentsClient) Recv() (*WatchDocumentsResponse, error) {
 	return m, nil
 }
 
+func (c *yorkieClient) WatchDocumentsStream(ctx context.Context, opts ...grpc.CallOption) (Yorkie_WatchDocumentsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[1], "/api.Yorkie/WatchDocumentsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &yorkieWatchDocumentsStreamClient{stream}
+	return x, nil
+}
+
+type Yorkie_WatchDocumentsStreamClient interface {
+	Send(*WatchDocumentsStreamRequest) error
+	Recv() (*WatchDocumentsResponse, error)
+	grpc.ClientStream
+}
+
+type yorkieWatchDocumentsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *yorkieWatchDocumentsStreamClient) Send(m *WatchDocumentsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *yorkieWatchDocumentsStreamClient) Recv() (*WatchDocumentsResponse, error) {
+	m := new(WatchDocumentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *yorkieClient) PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error) {
 	out := new(PushPullResponse)
 	err := c.cc.Invoke(ctx, "/api.Yorkie/PushPull", in, out, opts...)
@@ -3912,6 +4602,56 @@ func (c *yorkieClient) UpdateMetadata(ctx context.Context, in *UpdateMetadataReq
 	return out, nil
 }
 
+func (c *yorkieClient) FetchDocument(ctx context.Context, in *FetchDocumentRequest, opts ...grpc.CallOption) (*FetchDocumentResponse, error) {
+	out := new(FetchDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/FetchDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yorkieClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yorkieClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (Yorkie_WatchChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[2], "/api.Yorkie/WatchChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &yorkieWatchChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Yorkie_WatchChangesClient interface {
+	Recv() (*WatchChangesResponse, error)
+	grpc.ClientStream
+}
+
+type yorkieWatchChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *yorkieWatchChangesClient) Recv() (*WatchChangesResponse, error) {
+	m := new(WatchChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // YorkieServer is the server API for Yorkie service.
 type YorkieServer interface {
 	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
@@ -3919,8 +4659,12 @@ type YorkieServer interface {
 	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
 	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
 	WatchDocuments(*WatchDocumentsRequest, Yorkie_WatchDocumentsServer) error
+	WatchDocumentsStream(Yorkie_WatchDocumentsStreamServer) error
 	PushPull(context.Context, *PushPullRequest) (*PushPullResponse, error)
 	UpdateMetadata(context.Context, *UpdateMetadataRequest) (*UpdateMetadataResponse, error)
+	FetchDocument(context.Context, *FetchDocumentRequest) (*FetchDocumentResponse, error)
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	WatchChanges(*WatchChangesRequest, Yorkie_WatchChangesServer) error
 }
 
 // UnimplementedYorkieServer can be embedded to have forward compatible implementations.
@@ -3942,12 +4686,24 @@ func (*UnimplementedYorkieServer) DetachDocument(ctx context.Context, req *Detac
 func (*UnimplementedYorkieServer) WatchDocuments(req *WatchDocumentsRequest, srv Yorkie_WatchDocumentsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchDocuments not implemented")
 }
+func (*UnimplementedYorkieServer) WatchDocumentsStream(srv Yorkie_WatchDocumentsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDocumentsStream not implemented")
+}
 func (*UnimplementedYorkieServer) PushPull(ctx context.Context, req *PushPullRequest) (*PushPullResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushPull not implemented")
 }
 func (*UnimplementedYorkieServer) UpdateMetadata(ctx context.Context, req *UpdateMetadataRequest) (*UpdateMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
 }
+func (*UnimplementedYorkieServer) FetchDocument(ctx context.Context, req *FetchDocumentRequest) (*FetchDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchDocument not implemented")
+}
+func (*UnimplementedYorkieServer) Broadcast(ctx context.Context, req *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (*UnimplementedYorkieServer) WatchChanges(req *WatchChangesRequest, srv Yorkie_WatchChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
 
 func RegisterYorkieServer(s *grpc.Server, srv YorkieServer) {
 	s.RegisterService(&_Yorkie_serviceDesc, srv)
@@ -4046,6 +4802,32 @@ func (x *yorkieWatchDocumentsServer) Send(m *WatchDocumentsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Yorkie_WatchDocumentsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(YorkieServer).WatchDocumentsStream(&yorkieWatchDocumentsStreamServer{stream})
+}
+
+type Yorkie_WatchDocumentsStreamServer interface {
+	Send(*WatchDocumentsResponse) error
+	Recv() (*WatchDocumentsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type yorkieWatchDocumentsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieWatchDocumentsStreamServer) Send(m *WatchDocumentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *yorkieWatchDocumentsStreamServer) Recv() (*WatchDocumentsStreamRequest, error) {
+	m := new(WatchDocumentsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Yorkie_PushPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushPullRequest)
 	if err := dec(in); err != nil {
@@ -4082,6 +4864,63 @@ func _Yorkie_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Yorkie_FetchDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YorkieServer).FetchDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/FetchDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).FetchDocument(ctx, req.(*FetchDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Yorkie_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YorkieServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/Broadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).Broadcast(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Yorkie_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YorkieServer).WatchChanges(m, &yorkieWatchChangesServer{stream})
+}
+
+type Yorkie_WatchChangesServer interface {
+	Send(*WatchChangesResponse) error
+	grpc.ServerStream
+}
+
+type yorkieWatchChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieWatchChangesServer) Send(m *WatchChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Yorkie_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Yorkie",
 	HandlerType: (*YorkieServer)(nil),
@@ -4110,6 +4949,14 @@ var _Yorkie_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMetadata",
 			Handler:    _Yorkie_UpdateMetadata_Handler,
 		},
+		{
+			MethodName: "FetchDocument",
+			Handler:    _Yorkie_FetchDocument_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _Yorkie_Broadcast_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4117,6 +4964,17 @@ var _Yorkie_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Yorkie_WatchDocuments_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchDocumentsStream",
+			Handler:       _Yorkie_WatchDocumentsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _Yorkie_WatchChanges_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/yorkie.proto",
 }
@@ -4126,6 +4984,7 @@ var _Yorkie_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ClusterClient interface {
 	BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error)
+	PurgeAuthCache(ctx context.Context, in *PurgeAuthCacheRequest, opts ...grpc.CallOption) (*PurgeAuthCacheResponse, error)
 }
 
 type clusterClient struct {
@@ -4145,9 +5004,19 @@ func (c *clusterClient) BroadcastEvent(ctx context.Context, in *BroadcastEventRe
 	return out, nil
 }
 
+func (c *clusterClient) PurgeAuthCache(ctx context.Context, in *PurgeAuthCacheRequest, opts ...grpc.CallOption) (*PurgeAuthCacheResponse, error) {
+	out := new(PurgeAuthCacheResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/PurgeAuthCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 type ClusterServer interface {
 	BroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error)
+	PurgeAuthCache(context.Context, *PurgeAuthCacheRequest) (*PurgeAuthCacheResponse, error)
 }
 
 // UnimplementedClusterServer can be embedded to have forward compatible implementations.
@@ -4157,6 +5026,9 @@ type UnimplementedClusterServer struct {
 func (*UnimplementedClusterServer) BroadcastEvent(ctx context.Context, req *BroadcastEventRequest) (*BroadcastEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
 }
+func (*UnimplementedClusterServer) PurgeAuthCache(ctx context.Context, req *PurgeAuthCacheRequest) (*PurgeAuthCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAuthCache not implemented")
+}
 
 func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
 	s.RegisterService(&_Cluster_serviceDesc, srv)
@@ -4180,6 +5052,24 @@ func _Cluster_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_PurgeAuthCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAuthCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).PurgeAuthCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/PurgeAuthCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).PurgeAuthCache(ctx, req.(*PurgeAuthCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cluster_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Cluster",
 	HandlerType: (*ClusterServer)(nil),
@@ -4188,6 +5078,82 @@ var _Cluster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastEvent",
 			Handler:    _Cluster_BroadcastEvent_Handler,
 		},
+		{
+			MethodName: "PurgeAuthCache",
+			Handler:    _Cluster_PurgeAuthCache_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/yorkie.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	PurgeAuthCache(ctx context.Context, in *PurgeAuthCacheRequest, opts ...grpc.CallOption) (*PurgeAuthCacheResponse, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) PurgeAuthCache(ctx context.Context, in *PurgeAuthCacheRequest, opts ...grpc.CallOption) (*PurgeAuthCacheResponse, error) {
+	out := new(PurgeAuthCacheResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/PurgeAuthCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	PurgeAuthCache(context.Context, *PurgeAuthCacheRequest) (*PurgeAuthCacheResponse, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) PurgeAuthCache(ctx context.Context, req *PurgeAuthCacheRequest) (*PurgeAuthCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAuthCache not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_PurgeAuthCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAuthCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).PurgeAuthCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/PurgeAuthCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).PurgeAuthCache(ctx, req.(*PurgeAuthCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurgeAuthCache",
+			Handler:    _Admin_PurgeAuthCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/yorkie.proto",
@@ -4266,7 +5232,7 @@ func (m *BroadcastEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
+func (m *PurgeAuthCacheRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4276,12 +5242,12 @@ func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PurgeAuthCacheRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PurgeAuthCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4290,17 +5256,36 @@ func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Token)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
+func (m *PurgeAuthCacheResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4310,12 +5295,12 @@ func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PurgeAuthCacheResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PurgeAuthCacheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4324,9 +5309,75 @@ func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
+	if m.PurgedCount != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.PurgedCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
 		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0x12
@@ -4433,6 +5484,24 @@ func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SnapshotCompressions) > 0 {
+		dAtA4 := make([]byte, len(m.SnapshotCompressions)*10)
+		var j3 int
+		for _, num := range m.SnapshotCompressions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintYorkie(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ChangePack != nil {
 		{
 			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
@@ -4525,6 +5594,24 @@ func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SnapshotCompressions) > 0 {
+		dAtA8 := make([]byte, len(m.SnapshotCompressions)*10)
+		var j7 int
+		for _, num := range m.SnapshotCompressions {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintYorkie(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ChangePack != nil {
 		{
 			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
@@ -4617,6 +5704,16 @@ func (m *WatchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludeChanges {
+		i--
+		if m.IncludeChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DocumentKeys) > 0 {
 		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4777,6 +5874,83 @@ func (m *WatchDocumentsResponse_Initialization) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *WatchDocumentsStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeChanges {
+		i--
+		if m.IncludeChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RemovedDocumentKeys) > 0 {
+		for iNdEx := len(m.RemovedDocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedDocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddedDocumentKeys) > 0 {
+		for iNdEx := len(m.AddedDocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddedDocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PushPullRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4801,6 +5975,34 @@ func (m *PushPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SnapshotCompressions) > 0 {
+		dAtA17 := make([]byte, len(m.SnapshotCompressions)*10)
+		var j16 int
+		for _, num := range m.SnapshotCompressions {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintYorkie(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PushOnly {
+		i--
+		if m.PushOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ChangePack != nil {
 		{
 			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
@@ -4949,7 +6151,7 @@ func (m *UpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ChangePack) Marshal() (dAtA []byte, err error) {
+func (m *FetchDocumentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4959,12 +6161,12 @@ func (m *ChangePack) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
+func (m *FetchDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FetchDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4973,42 +6175,32 @@ func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MinSyncedTicket != nil {
-		{
-			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x18
 	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Snapshot)))
+	if len(m.SnapshotCompressions) > 0 {
+		dAtA22 := make([]byte, len(m.SnapshotCompressions)*10)
+		var j21 int
+		for _, num := range m.SnapshotCompressions {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintYorkie(dAtA, i, uint64(j21))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.Checkpoint != nil {
+	if m.DocumentKey != nil {
 		{
-			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5016,11 +6208,38 @@ func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if m.DocumentKey != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
 		{
-			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5033,7 +6252,7 @@ func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Change) Marshal() (dAtA []byte, err error) {
+func (m *BroadcastRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5043,12 +6262,12 @@ func (m *Change) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Change) MarshalTo(dAtA []byte) (int, error) {
+func (m *BroadcastRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BroadcastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5057,10 +6276,17 @@ func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5068,19 +6294,12 @@ func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintYorkie(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != nil {
+	if m.Client != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5093,7 +6312,7 @@ func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChangeID) Marshal() (dAtA []byte, err error) {
+func (m *BroadcastResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5103,12 +6322,12 @@ func (m *ChangeID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
+func (m *BroadcastResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BroadcastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5117,27 +6336,10 @@ func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Lamport != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
+func (m *WatchChangesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5147,12 +6349,12 @@ func (m *Operation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchChangesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5161,154 +6363,62 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Set != nil {
-		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if m.StartTime != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.StartTime))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Add != nil {
-		{
-			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Cursor)))
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Move != nil {
-		{
-			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Collection)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
+func (m *WatchChangesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Edit != nil {
-		{
-			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *WatchChangesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WatchChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Select != nil {
-		{
-			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Cursor)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichEdit != nil {
+	if m.Change != nil {
 		{
-			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5316,41 +6426,16 @@ func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Style != nil {
-		{
-			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x10
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increase != nil {
+	if m.DocumentKey != nil {
 		{
-			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5358,11 +6443,12 @@ func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
+
+func (m *ChangePack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5372,12 +6458,12 @@ func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5386,9 +6472,9 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
+	if m.MinSyncedTicket != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5396,11 +6482,32 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Checkpoint != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5408,18 +6515,11 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.ParentCreatedAt != nil {
+	if m.DocumentKey != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5432,7 +6532,7 @@ func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
+func (m *Change) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5442,12 +6542,12 @@ func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
+func (m *Change) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5456,45 +6556,30 @@ func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ParentCreatedAt != nil {
+	if m.Id != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5507,7 +6592,7 @@ func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
+func (m *ChangeID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5517,12 +6602,12 @@ func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5531,58 +6616,27 @@ func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if m.Lamport != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if m.ClientSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
+func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5592,12 +6646,12 @@ func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5606,21 +6660,28 @@ func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
+	if m.Body != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5628,11 +6689,20 @@ func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5640,38 +6710,20 @@ func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
+	if m.Move != nil {
 		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5679,44 +6731,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x1a
 	}
-	if m.To != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5724,11 +6752,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.From != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Edit != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5736,11 +6773,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Select != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5748,50 +6794,20 @@ func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.To != nil {
+	if m.RichEdit != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5799,11 +6815,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	if m.From != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Style != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5811,11 +6836,20 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if m.ParentCreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Increase != nil {
 		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5823,12 +6857,11 @@ func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5838,12 +6871,12 @@ func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5862,63 +6895,11 @@ func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x22
 	}
-	if m.To != nil {
+	if m.Value != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5928,15 +6909,10 @@ func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5955,7 +6931,7 @@ func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5965,12 +6941,12 @@ func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5989,30 +6965,11 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x22
 	}
-	if m.To != nil {
+	if m.Value != nil {
 		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6022,9 +6979,9 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.From != nil {
+	if m.PrevCreatedAt != nil {
 		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6049,7 +7006,7 @@ func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6059,12 +7016,12 @@ func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6083,11 +7040,23 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Value != nil {
+	if m.PrevCreatedAt != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6112,7 +7081,7 @@ func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6122,12 +7091,12 @@ func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6136,21 +7105,9 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6160,9 +7117,9 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.MovedAt != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6172,9 +7129,9 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6187,7 +7144,7 @@ func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6197,12 +7154,12 @@ func (m *JSONElement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6211,28 +7168,54 @@ func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Body != nil {
+	if m.ExecutedAt != nil {
 		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonObject != nil {
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6240,20 +7223,11 @@ func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonArray != nil {
+	if m.From != nil {
 		{
-			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6263,18 +7237,9 @@ func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Primitive != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6282,20 +7247,38 @@ func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Text != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6305,18 +7288,9 @@ func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichText != nil {
+	if m.To != nil {
 		{
-			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6324,20 +7298,11 @@ func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Counter != nil {
+	if m.From != nil {
 		{
-			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6345,11 +7310,24 @@ func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
+
+func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6359,12 +7337,12 @@ func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6373,9 +7351,9 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6383,11 +7361,63 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x3a
 	}
-	if m.MovedAt != nil {
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6397,9 +7427,9 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6409,24 +7439,22 @@ func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6436,12 +7464,12 @@ func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6450,9 +7478,9 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6460,23 +7488,42 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.CreatedAt != nil {
+	if m.From != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6486,24 +7533,22 @@ func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
+func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6513,12 +7558,12 @@ func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6527,9 +7572,9 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.ExecutedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6537,11 +7582,11 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.MovedAt != nil {
+	if m.Value != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6549,11 +7594,11 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.CreatedAt != nil {
+	if m.ParentCreatedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6561,24 +7606,12 @@ func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
+func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6588,12 +7621,12 @@ func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6602,6 +7635,18 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RemovedAt != nil {
 		{
 			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -6612,7 +7657,7 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if m.MovedAt != nil {
 		{
@@ -6624,7 +7669,7 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.CreatedAt != nil {
 		{
@@ -6636,26 +7681,12 @@ func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6665,12 +7696,12 @@ func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6679,21 +7710,28 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
+	if m.Body != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.MovedAt != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonObject != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6701,11 +7739,20 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonArray != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6715,50 +7762,18 @@ func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RemovedAt != nil {
+	if m.Primitive != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6766,11 +7781,20 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.MovedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Text != nil {
 		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6780,9 +7804,18 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RichText != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6790,50 +7823,20 @@ func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RHTNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Element != nil {
+	if m.Counter != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6841,19 +7844,11 @@ func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *RGANode) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6863,12 +7858,12 @@ func (m *RGANode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6877,9 +7872,9 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Element != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6887,11 +7882,11 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.Next != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6899,12 +7894,38 @@ func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNode) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6914,12 +7935,12 @@ func (m *TextNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6928,9 +7949,9 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InsPrevId != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6940,9 +7961,9 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.RemovedAt != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6952,16 +7973,9 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6969,12 +7983,26 @@ func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6984,12 +8012,12 @@ func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6998,9 +8026,33 @@ func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7017,17 +8069,15 @@ func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7037,12 +8087,12 @@ func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7051,9 +8101,9 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InsPrevId != nil {
+	if m.RemovedAt != nil {
 		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7061,11 +8111,11 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if m.RemovedAt != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7073,44 +8123,11 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Id != nil {
+	if m.CreatedAt != nil {
 		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7118,12 +8135,26 @@ func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7133,12 +8164,12 @@ func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7147,14 +8178,21 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
 	}
-	if m.CreatedAt != nil {
+	if m.MovedAt != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7162,12 +8200,38 @@ func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7177,12 +8241,12 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7191,34 +8255,58 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for k := range m.Data {
-			v := m.Data[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Clock != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Clock))
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Client) Marshal() (dAtA []byte, err error) {
+func (m *RHTNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7228,12 +8316,12 @@ func (m *Client) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Client) MarshalTo(dAtA []byte) (int, error) {
+func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7242,9 +8330,9 @@ func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Metadata != nil {
+	if m.Element != nil {
 		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7254,17 +8342,17 @@ func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Id)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clients) Marshal() (dAtA []byte, err error) {
+func (m *RGANode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7274,12 +8362,12 @@ func (m *Clients) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
+func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7288,24 +8376,34 @@ func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Clients) > 0 {
-		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocumentKey) Marshal() (dAtA []byte, err error) {
+func (m *TextNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7315,12 +8413,12 @@ func (m *DocumentKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocumentKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocumentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7329,24 +8427,53 @@ func (m *DocumentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Document) > 0 {
-		i -= len(m.Document)
-		copy(dAtA[i:], m.Document)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Document)))
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Collection) > 0 {
-		i -= len(m.Collection)
-		copy(dAtA[i:], m.Collection)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Collection)))
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7356,12 +8483,12 @@ func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7370,20 +8497,36 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7393,12 +8536,12 @@ func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7407,19 +8550,9 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RelativeOffset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.RelativeOffset))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Offset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CreatedAt != nil {
+	if m.InsPrevId != nil {
 		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7427,12 +8560,69 @@ func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7442,12 +8632,12 @@ func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7456,27 +8646,27 @@ func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Delimiter != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Delimiter))
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Lamport != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7486,12 +8676,12 @@ func (m *DocEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7500,23 +8690,60 @@ func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Publisher != nil {
+	if m.Clock != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Clock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Client) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Client) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Metadata != nil {
 		{
-			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7526,137 +8753,328 @@ func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
-	offset -= sovYorkie(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Clients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BroadcastEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublisherId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BroadcastEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.ClientSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeactivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.RelativeOffset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.RelativeOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeactivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delimiter != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Delimiter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Publisher != nil {
+		{
+			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AttachDocumentRequest) Size() (n int) {
+func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
+	offset -= sovYorkie(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BroadcastEventRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ClientId)
+	l = len(m.PublisherId)
 	if l > 0 {
 		n += 1 + l + sovYorkie(uint64(l))
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
+	if m.Event != nil {
+		l = m.Event.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -7665,33 +9083,179 @@ func (m *AttachDocumentRequest) Size() (n int) {
 	return n
 }
 
-func (m *AttachDocumentResponse) Size() (n int) {
+func (m *BroadcastEventResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DetachDocumentRequest) Size() (n int) {
+func (m *PurgeAuthCacheRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ClientId)
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeAuthCacheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PurgedCount != 0 {
+		n += 1 + sovYorkie(uint64(m.PurgedCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.SnapshotCompressions) > 0 {
+		l = 0
+		for _, e := range m.SnapshotCompressions {
+			l += sovYorkie(uint64(e))
+		}
+		n += 1 + sovYorkie(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
 	if l > 0 {
 		n += 1 + l + sovYorkie(uint64(l))
 	}
@@ -7699,6 +9263,13 @@ func (m *DetachDocumentRequest) Size() (n int) {
 		l = m.ChangePack.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
+	if len(m.SnapshotCompressions) > 0 {
+		l = 0
+		for _, e := range m.SnapshotCompressions {
+			l += sovYorkie(uint64(e))
+		}
+		n += 1 + sovYorkie(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7741,6 +9312,9 @@ func (m *WatchDocumentsRequest) Size() (n int) {
 			n += 1 + l + sovYorkie(uint64(l))
 		}
 	}
+	if m.IncludeChanges {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7811,6 +9385,37 @@ func (m *WatchDocumentsResponse_Initialization) Size() (n int) {
 	return n
 }
 
+func (m *WatchDocumentsStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.AddedDocumentKeys) > 0 {
+		for _, e := range m.AddedDocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if len(m.RemovedDocumentKeys) > 0 {
+		for _, e := range m.RemovedDocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.IncludeChanges {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PushPullRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7825,6 +9430,16 @@ func (m *PushPullRequest) Size() (n int) {
 		l = m.ChangePack.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
+	if m.PushOnly {
+		n += 2
+	}
+	if len(m.SnapshotCompressions) > 0 {
+		l = 0
+		for _, e := range m.SnapshotCompressions {
+			l += sovYorkie(uint64(e))
+		}
+		n += 1 + sovYorkie(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7885,7 +9500,7 @@ func (m *UpdateMetadataResponse) Size() (n int) {
 	return n
 }
 
-func (m *ChangePack) Size() (n int) {
+func (m *FetchDocumentRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7895,23 +9510,15 @@ func (m *ChangePack) Size() (n int) {
 		l = m.DocumentKey.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+	if len(m.SnapshotCompressions) > 0 {
+		l = 0
+		for _, e := range m.SnapshotCompressions {
+			l += sovYorkie(uint64(e))
 		}
+		n += 1 + sovYorkie(uint64(l)) + l
 	}
-	if m.MinSyncedTicket != nil {
-		l = m.MinSyncedTicket.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7919,45 +9526,39 @@ func (m *ChangePack) Size() (n int) {
 	return n
 }
 
-func (m *Change) Size() (n int) {
+func (m *FetchDocumentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ChangeID) Size() (n int) {
+func (m *BroadcastRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ClientSeq != 0 {
-		n += 1 + sovYorkie(uint64(m.ClientSeq))
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
 	}
-	if m.Lamport != 0 {
-		n += 1 + sovYorkie(uint64(m.Lamport))
+	if len(m.DocumentKeys) > 0 {
+		for _, e := range m.DocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
+	l = len(m.Payload)
 	if l > 0 {
 		n += 1 + l + sovYorkie(uint64(l))
 	}
@@ -7967,56 +9568,200 @@ func (m *ChangeID) Size() (n int) {
 	return n
 }
 
-func (m *Operation) Size() (n int) {
+func (m *BroadcastResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Operation_Set_) Size() (n int) {
+func (m *WatchChangesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
 		n += 1 + l + sovYorkie(uint64(l))
 	}
+	if m.StartTime != 0 {
+		n += 1 + sovYorkie(uint64(m.StartTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
 	return n
 }
-func (m *Operation_Add_) Size() (n int) {
+
+func (m *WatchChangesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Add != nil {
-		l = m.Add.Size()
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
-	return n
-}
-func (m *Operation_Move_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
 	}
-	var l int
-	_ = l
-	if m.Move != nil {
-		l = m.Move.Size()
+	if m.Change != nil {
+		l = m.Change.Size()
 		n += 1 + l + sovYorkie(uint64(l))
 	}
-	return n
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.MinSyncedTicket != nil {
+		l = m.MinSyncedTicket.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Change) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ClientSeq))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovYorkie(uint64(m.Lamport))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Set_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Add_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Move_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Move != nil {
+		l = m.Move.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
 }
 func (m *Operation_Remove_) Size() (n int) {
 	if m == nil {
@@ -8998,19 +10743,1131 @@ func (m *DocEvent) Size() (n int) {
 			n += 1 + l + sovYorkie(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovYorkie(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozYorkie(x uint64) (n int) {
+	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherId = append(m.PublisherId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublisherId == nil {
+				m.PublisherId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &DocEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeAuthCacheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeAuthCacheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeAuthCacheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeAuthCacheResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeAuthCacheResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeAuthCacheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgedCount", wireType)
+			}
+			m.PurgedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurgedCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v SnapshotCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SnapshotCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SnapshotCompressions) == 0 {
+					m.SnapshotCompressions = make([]SnapshotCompression, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v SnapshotCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SnapshotCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCompressions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovYorkie(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozYorkie(x uint64) (n int) {
-	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
+func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9033,15 +11890,15 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9068,14 +11925,14 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublisherId = append(m.PublisherId[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublisherId == nil {
-				m.PublisherId = []byte{}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9102,13 +11959,82 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &DocEvent{}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v SnapshotCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SnapshotCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SnapshotCompressions) == 0 {
+					m.SnapshotCompressions = make([]SnapshotCompression, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v SnapshotCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SnapshotCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCompressions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9134,7 +12060,7 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
+func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9157,12 +12083,80 @@ func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9188,7 +12182,7 @@ func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9211,17 +12205,17 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9231,24 +12225,82 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeChanges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeChanges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9274,7 +12326,7 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9297,17 +12349,17 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9317,29 +12369,32 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			v := &WatchDocumentsResponse_Initialization{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentsResponse_Initialization_{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9349,25 +12404,26 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			v := &DocEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Body = &WatchDocumentsResponse_Event{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9394,7 +12450,7 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9417,17 +12473,17 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9437,25 +12493,120 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.PeersMapByDoc == nil {
+				m.PeersMapByDoc = make(map[string]*Clients)
+			}
+			var mapkey string
+			var mapvalue *Clients
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clients{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.PeersMapByDoc[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9482,7 +12633,7 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9505,17 +12656,17 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9525,85 +12676,33 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.Client == nil {
+				m.Client = &Client{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYorkie(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYorkie
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedDocumentKeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9613,29 +12712,29 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			m.AddedDocumentKeys = append(m.AddedDocumentKeys, &DocumentKey{})
+			if err := m.AddedDocumentKeys[len(m.AddedDocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedDocumentKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9662,13 +12761,31 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovedDocumentKeys = append(m.RemovedDocumentKeys, &DocumentKey{})
+			if err := m.RemovedDocumentKeys[len(m.RemovedDocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeChanges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeChanges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9694,7 +12811,7 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9717,10 +12834,10 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9793,6 +12910,95 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PushOnly = bool(v != 0)
+		case 4:
+			if wireType == 0 {
+				var v SnapshotCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SnapshotCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SnapshotCompressions) == 0 {
+					m.SnapshotCompressions = make([]SnapshotCompression, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v SnapshotCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SnapshotCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCompressions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9818,7 +13024,7 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9841,10 +13047,10 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9942,7 +13148,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9965,17 +13171,17 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9985,27 +13191,31 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10032,10 +13242,8 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10064,7 +13272,7 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10087,82 +13295,12 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Client == nil {
-				m.Client = &Client{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
-			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10188,7 +13326,7 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *FetchDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10211,52 +13349,122 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v SnapshotCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= SnapshotCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthYorkie
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.SnapshotCompressions) == 0 {
+					m.SnapshotCompressions = make([]SnapshotCompression, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v SnapshotCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= SnapshotCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SnapshotCompressions = append(m.SnapshotCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCompressions", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &WatchDocumentsResponse_Initialization{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &WatchDocumentsResponse_Initialization_{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var msglen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10266,27 +13474,11 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DocEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &WatchDocumentsResponse_Event{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10312,7 +13504,7 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
+func (m *FetchDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10335,15 +13527,15 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10370,105 +13562,12 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PeersMapByDoc == nil {
-				m.PeersMapByDoc = make(map[string]*Clients)
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
 			}
-			var mapkey string
-			var mapvalue *Clients
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYorkie
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Clients{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipYorkie(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PeersMapByDoc[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10495,7 +13594,7 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
+func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10518,17 +13617,17 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BroadcastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10538,29 +13637,31 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10584,16 +13685,102 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10619,7 +13806,7 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10642,17 +13829,17 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10662,31 +13849,29 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
-			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10696,28 +13881,43 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10743,7 +13943,7 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10766,15 +13966,15 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10801,16 +14001,35 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &Client{}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
 			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10837,65 +14056,45 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
-			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Change == nil {
+				m.Change = &Change{}
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYorkie(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYorkie
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateMetadataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYorkie
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMetadataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -17799,6 +20998,76 @@ func (m *DocEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -18,24 +18,50 @@ package client
 
 import (
 	"context"
+	"sync/atomic"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/pkg/types"
 )
 
+// TokenProvider returns the token to authenticate the RPCs. It is called for
+// every RPC and every stream, including the streams reopened to reconnect, so
+// it should cache the token until it expires. The expired is true if the agent
+// rejected the last token as expired, and a new token should be issued.
+type TokenProvider func(ctx context.Context, expired bool) (string, error)
+
 // AuthInterceptor is an interceptor for authentication.
 type AuthInterceptor struct {
-	token string
+	provider TokenProvider
+
+	// expired is set to 1 when a stream is rejected with the expired token,
+	// so that the token is refreshed for the next RPC.
+	expired int32
 }
 
-// NewAuthInterceptor creates a new instance of AuthInterceptor.
+// NewAuthInterceptor creates a new instance of AuthInterceptor with the given
+// static token.
 func NewAuthInterceptor(token string) *AuthInterceptor {
+	return NewAuthInterceptorWithProvider(func(ctx context.Context, expired bool) (string, error) {
+		return token, nil
+	})
+}
+
+// NewAuthInterceptorWithProvider creates a new instance of AuthInterceptor
+// that gets the tokens from the given provider.
+func NewAuthInterceptorWithProvider(provider TokenProvider) *AuthInterceptor {
 	return &AuthInterceptor{
-		token: token,
+		provider: provider,
 	}
 }
 
-// Unary creates a unary server interceptor for authorization.
+// Unary creates a unary server interceptor for authorization. If the token has
+// expired, the RPC is retried once with the refreshed token.
 func (i *AuthInterceptor) Unary() grpc.UnaryClientInterceptor {
 	return func(
 		ctx context.Context,
@@ -46,12 +72,27 @@ func (i *AuthInterceptor) Unary() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", i.token))
-		return invoker(ctx, method, req, reply, cc, opts...)
+		token, err := i.token(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = invoker(withToken(ctx, token), method, req, reply, cc, opts...)
+		if !IsTokenExpired(err) {
+			return err
+		}
+
+		token, err = i.provider(ctx, true)
+		if err != nil {
+			return err
+		}
+		return invoker(withToken(ctx, token), method, req, reply, cc, opts...)
 	}
 }
 
-// Stream creates a stream server interceptor for authorization.
+// Stream creates a stream server interceptor for authorization. A stream can
+// not change its token once opened, so if it is rejected with the expired
+// token, the token is refreshed for the stream opened next.
 func (i *AuthInterceptor) Stream() grpc.StreamClientInterceptor {
 	return func(
 		ctx context.Context,
@@ -61,7 +102,77 @@ func (i *AuthInterceptor) Stream() grpc.StreamClientInterceptor {
 		streamer grpc.Streamer,
 		opts ...grpc.CallOption,
 	) (grpc.ClientStream, error) {
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", i.token))
-		return streamer(ctx, desc, cc, method, opts...)
+		token, err := i.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(withToken(ctx, token), desc, cc, method, opts...)
+		if err != nil {
+			i.checkExpired(err)
+			return nil, err
+		}
+		return &authClientStream{ClientStream: stream, interceptor: i}, nil
+	}
+}
+
+// token returns the token from the provider. It is refreshed if the last
+// token has been rejected as expired.
+func (i *AuthInterceptor) token(ctx context.Context) (string, error) {
+	expired := atomic.SwapInt32(&i.expired, 0) == 1
+	token, err := i.provider(ctx, expired)
+	if err != nil && expired {
+		atomic.StoreInt32(&i.expired, 1)
+	}
+
+	return token, err
+}
+
+// checkExpired marks the token to be refreshed if the given error is of the
+// expired token.
+func (i *AuthInterceptor) checkExpired(err error) {
+	if IsTokenExpired(err) {
+		atomic.StoreInt32(&i.expired, 1)
 	}
 }
+
+// authClientStream is a grpc.ClientStream that tells the interceptor when the
+// stream is rejected with the expired token.
+type authClientStream struct {
+	grpc.ClientStream
+	interceptor *AuthInterceptor
+}
+
+// RecvMsg receives a message from the stream.
+func (s *authClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.interceptor.checkExpired(err)
+	}
+	return err
+}
+
+// IsTokenExpired returns whether the given error is returned by the agent
+// because the token has expired. The token should be refreshed instead of
+// retrying the RPC with it.
+func IsTokenExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	st, ok := grpcstatus.FromError(err)
+	if !ok || st.Code() != codes.Unauthenticated {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == types.TokenExpiredReason {
+			return true
+		}
+	}
+	return false
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", token))
+}
@@ -18,16 +18,28 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -52,12 +64,69 @@ var (
 	// ErrUnsupportedWatchResponseType occurs when the given WatchResponseType
 	// is not supported.
 	ErrUnsupportedWatchResponseType = errors.New("unsupported watch response type")
+
+	// ErrWatchNotStarted occurs when the documents to watch are changed before
+	// watching starts.
+	ErrWatchNotStarted = errors.New("watch is not started")
+
+	// ErrWatchStreamNotSupported occurs when the documents of the watch are
+	// changed while the agent does not support the watch stream.
+	ErrWatchStreamNotSupported = errors.New("watch stream is not supported by the agent")
+
+	// ErrPeerNotFound occurs when the given peer is not watching the document.
+	ErrPeerNotFound = errors.New("peer not found")
+)
+
+// snapshotCompressions is the compressions of snapshots that the client can
+// decode, in order of preference.
+var snapshotCompressions = []api.SnapshotCompression{
+	api.SnapshotCompression_ZSTD,
+	api.SnapshotCompression_GZIP,
+	api.SnapshotCompression_NO_COMPRESSION,
+}
+
+// SyncMode is the mode of synchronization of the attached document.
+type SyncMode int
+
+// The values below are types of SyncMode.
+const (
+	// SyncModePushPull pushes local changes and pulls remote changes.
+	SyncModePushPull SyncMode = iota
+
+	// SyncModePushOnly only pushes local changes. Remote changes are not
+	// pulled until the mode is changed.
+	//
+	// NOTE: The agent keeps the checkpoint of the client at the last pulled
+	//       change, so the garbage collection of the document is held back
+	//       until the mode is changed or the document is detached.
+	SyncModePushOnly
+
+	// SyncModePullOnly only pulls remote changes. Local changes are kept in
+	// the document and are not pushed until the mode is changed.
+	SyncModePullOnly
+
+	// SyncModePaused skips the synchronization of the document. Like
+	// SyncModePushOnly, it holds back the garbage collection of the document.
+	SyncModePaused
 )
 
+// watchStream is the stream of a watch. It is either the stream of
+// WatchDocumentsStream, to which the documents can be added, or the stream of
+// WatchDocuments of the agents that do not support the former.
+type watchStream interface {
+	Recv() (*api.WatchDocumentsResponse, error)
+}
+
 // Attachment represents the document attached and peers.
 type Attachment struct {
-	doc   *document.Document
-	peers map[string]types.MetadataInfo
+	doc      *document.Document
+	peers    map[string]types.MetadataInfo
+	syncMode SyncMode
+
+	// watchStream is the stream of the watch that watches the document. It
+	// is nil if the document is not watched. The metadata of this client is
+	// only sent to the watched documents.
+	watchStream watchStream
 }
 
 // Client is a normal client that can communicate with the agent.
@@ -73,6 +142,14 @@ type Client struct {
 	metadataInfo types.MetadataInfo
 	status       status
 	attachments  map[string]*Attachment
+	watchChanges bool
+
+	// watchMu guards the watch streams of this client and its attachments.
+	watchMu sync.Mutex
+
+	// watchStream is the stream of the latest live watch, to which AddWatch
+	// adds the documents. It is nil if no watch is live.
+	watchStream watchStream
 }
 
 // Option configures how we set up the client.
@@ -81,8 +158,31 @@ type Option struct {
 	Metadata types.Metadata
 	Token    string
 
+	// TokenProvider provides the tokens instead of the static Token, so that
+	// the long-lived clients can refresh the short-lived tokens. It is
+	// consulted for every RPC and whenever Watch reconnects.
+	TokenProvider TokenProvider
+
 	CertFile           string
 	ServerNameOverride string
+
+	// ClientCertFile and ClientKeyFile are the certificate and the key that
+	// the client presents to the agent. The agents that verify client
+	// certificates authenticate the client by them without the token.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// WatchChanges makes the agent deliver the changes of the documents in
+	// DocumentsChanged responses of Watch. The changes can be applied with
+	// ApplyWatchResponse instead of PushPull.
+	WatchChanges bool
+}
+
+// AttachOption configures how we attach the document.
+type AttachOption struct {
+	// SyncMode is the mode of synchronization after the document is attached.
+	// The document is always fully synchronized while attaching.
+	SyncMode SyncMode
 }
 
 // WatchResponseType is type of watch response.
@@ -92,16 +192,51 @@ type WatchResponseType string
 const (
 	DocumentsChanged WatchResponseType = "documents-changed"
 	PeersChanged     WatchResponseType = "peers-changed"
+	Broadcasted      WatchResponseType = "broadcasted"
+)
+
+// PeerChangeType is type of the change of a peer.
+type PeerChangeType string
+
+// The values below are types of PeerChangeType.
+const (
+	PeerJoined          PeerChangeType = "peer-joined"
+	PeerLeft            PeerChangeType = "peer-left"
+	PeerPresenceChanged PeerChangeType = "peer-presence-changed"
 )
 
+// PeerChange represents which peer joined, left or changed its presence in
+// the documents.
+type PeerChange struct {
+	Type     PeerChangeType
+	PeerID   string
+	Keys     []*key.Key
+	Presence types.Presence
+}
+
 // WatchResponse is a structure representing response of Watch.
 type WatchResponse struct {
 	Type          WatchResponseType
 	Keys          []*key.Key
+	ChangePack    *change.Pack
 	PeersMapByDoc map[string]map[string]types.Metadata
+	PeerChange    *PeerChange
+	Publisher     string
+	Payload       []byte
 	Err           error
 }
 
+// CapturedChange is a stored change delivered by WatchChanges.
+type CapturedChange struct {
+	DocumentKey *key.Key
+	ServerSeq   uint64
+	Change      *change.Change
+
+	// Cursor is the position to resume WatchChanges after this change.
+	Cursor string
+	Err    error
+}
+
 // NewClient creates an instance of Client.
 func NewClient(opts ...Option) (*Client, error) {
 	var k string
@@ -125,12 +260,15 @@ func NewClient(opts ...Option) (*Client, error) {
 		serverNameOverride = opts[0].ServerNameOverride
 	}
 
+	var clientCertFile, clientKeyFile string
+	if len(opts) > 0 {
+		clientCertFile = opts[0].ClientCertFile
+		clientKeyFile = opts[0].ClientKeyFile
+	}
+
 	var dialOptions []grpc.DialOption
-	if certFile != "" {
-		creds, err := credentials.NewClientTLSFromFile(
-			certFile,
-			serverNameOverride,
-		)
+	if certFile != "" || clientCertFile != "" {
+		creds, err := newTransportCredentials(certFile, serverNameOverride, clientCertFile, clientKeyFile)
 		if err != nil {
 			log.Logger.Error(err)
 			return nil, err
@@ -140,27 +278,66 @@ func NewClient(opts ...Option) (*Client, error) {
 		dialOptions = append(dialOptions, grpc.WithInsecure())
 	}
 
-	var token string
-	if len(opts) > 0 && opts[0].Token != "" {
-		token = opts[0].Token
+	var authInterceptor *AuthInterceptor
+	if len(opts) > 0 && opts[0].TokenProvider != nil {
+		authInterceptor = NewAuthInterceptorWithProvider(opts[0].TokenProvider)
+	} else if len(opts) > 0 && opts[0].Token != "" {
+		authInterceptor = NewAuthInterceptor(opts[0].Token)
 	}
-	if token != "" {
-		authInterceptor := NewAuthInterceptor(token)
+	if authInterceptor != nil {
 		dialOptions = append(dialOptions, grpc.WithUnaryInterceptor(authInterceptor.Unary()))
 		dialOptions = append(dialOptions, grpc.WithStreamInterceptor(authInterceptor.Stream()))
 	}
 
+	watchChanges := len(opts) > 0 && opts[0].WatchChanges
+
 	return &Client{
 		key: k,
 		metadataInfo: types.MetadataInfo{
 			Data: metadata,
 		},
-		dialOptions: dialOptions,
-		status:      deactivated,
-		attachments: make(map[string]*Attachment),
+		dialOptions:  dialOptions,
+		status:       deactivated,
+		attachments:  make(map[string]*Attachment),
+		watchChanges: watchChanges,
 	}, nil
 }
 
+// newTransportCredentials creates the TLS credentials to connect to the agent.
+// The certificate of the agent is verified with the CA certificates of the
+// given cert file, or the system ones if it is empty.
+func newTransportCredentials(
+	certFile string,
+	serverNameOverride string,
+	clientCertFile string,
+	clientKeyFile string,
+) (credentials.TransportCredentials, error) {
+	if clientCertFile == "" {
+		return credentials.NewClientTLSFromFile(certFile, serverNameOverride)
+	}
+
+	tlsConfig := &tls.Config{ServerName: serverNameOverride}
+	if certFile != "" {
+		caCerts, err := ioutil.ReadFile(filepath.Clean(certFile))
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("%s: no certificate", certFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	cert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
 // Dial creates an instance of Client and dials the given rpcAddr.
 func Dial(rpcAddr string, opts ...Option) (*Client, error) {
 	cli, err := NewClient(opts...)
@@ -253,6 +430,16 @@ func (c *Client) Deactivate(ctx context.Context) error {
 // Attach attaches the given document to this client. It tells the agent that
 // this client will synchronize the given document.
 func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
+	return c.AttachWithOption(ctx, doc, AttachOption{})
+}
+
+// AttachWithOption attaches the given document to this client with the given
+// option.
+func (c *Client) AttachWithOption(
+	ctx context.Context,
+	doc *document.Document,
+	opt AttachOption,
+) error {
 	if c.status != activated {
 		return ErrClientNotActivated
 	}
@@ -265,8 +452,9 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 	}
 
 	res, err := c.client.AttachDocument(ctx, &api.AttachDocumentRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
+		ClientId:             c.id.Bytes(),
+		ChangePack:           pbChangePack,
+		SnapshotCompressions: snapshotCompressions,
 	})
 	if err != nil {
 		log.Logger.Error(err)
@@ -285,8 +473,9 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 
 	doc.SetStatus(document.Attached)
 	c.attachments[doc.Key().BSONKey()] = &Attachment{
-		doc:   doc,
-		peers: make(map[string]types.MetadataInfo),
+		doc:      doc,
+		peers:    make(map[string]types.MetadataInfo),
+		syncMode: opt.SyncMode,
 	}
 
 	return nil
@@ -313,8 +502,9 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 	}
 
 	res, err := c.client.DetachDocument(ctx, &api.DetachDocumentRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
+		ClientId:             c.id.Bytes(),
+		ChangePack:           pbChangePack,
+		SnapshotCompressions: snapshotCompressions,
 	})
 	if err != nil {
 		log.Logger.Error(err)
@@ -337,9 +527,70 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 	return nil
 }
 
+// Fetch returns the current snapshot of the given document without
+// activating the client or attaching the document. The client does not
+// appear as a peer of the document and does not affect the garbage collection
+// of it, so it can be used by batch exporters or search indexers.
+func (c *Client) Fetch(
+	ctx context.Context,
+	collection string,
+	docKey string,
+) (*document.Document, error) {
+	return c.FetchAt(ctx, collection, docKey, 0)
+}
+
+// FetchAt returns the snapshot of the given document at the given server seq
+// in the same way as Fetch. If the server seq is 0, the current snapshot is
+// returned.
+func (c *Client) FetchAt(
+	ctx context.Context,
+	collection string,
+	docKey string,
+	serverSeq uint64,
+) (*document.Document, error) {
+	doc := document.New(collection, docKey)
+
+	res, err := c.client.FetchDocument(ctx, &api.FetchDocumentRequest{
+		DocumentKey:          converter.ToDocumentKey(doc.Key()),
+		SnapshotCompressions: snapshotCompressions,
+		ServerSeq:            serverSeq,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	pack, err := converter.FromChangePack(res.ChangePack)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.ApplyChangePack(pack); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// SetSyncMode changes the mode of synchronization of the given document.
+func (c *Client) SetSyncMode(doc *document.Document, mode SyncMode) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	attachment, ok := c.attachments[doc.Key().BSONKey()]
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	attachment.syncMode = mode
+	return nil
+}
+
 // Sync pushes local changes of the attached documents to the Agent and
 // receives changes of the remote replica from the agent then apply them to
-// local documents.
+// local documents. Each document is synchronized according to its SyncMode.
 func (c *Client) Sync(ctx context.Context, keys ...*key.Key) error {
 	if len(keys) == 0 {
 		for _, attachment := range c.attachments {
@@ -356,11 +607,67 @@ func (c *Client) Sync(ctx context.Context, keys ...*key.Key) error {
 	return nil
 }
 
+// ApplyWatchResponse applies the changes delivered in the given
+// DocumentsChanged response to the attached document. If the response does not
+// have the changes or some changes are missing, it falls back to PushPull.
+func (c *Client) ApplyWatchResponse(ctx context.Context, resp WatchResponse) error {
+	if resp.ChangePack == nil {
+		return c.Sync(ctx, resp.Keys...)
+	}
+
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	pack := resp.ChangePack
+	attachment, ok := c.attachments[pack.DocumentKey.BSONKey()]
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+
+	if attachment.syncMode == SyncModePaused ||
+		attachment.syncMode == SyncModePushOnly {
+		return nil
+	}
+
+	cp := attachment.doc.Checkpoint()
+	lastServerSeq := pack.Checkpoint.ServerSeq
+	if lastServerSeq <= cp.ServerSeq {
+		return nil
+	}
+
+	// NOTE: Server seqs of the delivered changes are contiguous, so a gap
+	//       between the document and the changes means missing changes.
+	firstServerSeq := lastServerSeq - uint64(len(pack.Changes)) + 1
+	if firstServerSeq != cp.ServerSeq+1 {
+		return c.sync(ctx, pack.DocumentKey)
+	}
+
+	remotePack := change.NewPack(
+		pack.DocumentKey,
+		checkpoint.New(lastServerSeq, cp.ClientSeq),
+		c.filterRemoteChanges(pack.Changes),
+		nil,
+	)
+	// NOTE: The delivered changes do not have the min synced ticket, so the
+	//       garbage is collected by the next PushPull.
+	remotePack.MinSyncedTicket = time.InitialTicket
+	if err := attachment.doc.ApplyChangePack(remotePack); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
 // Watch subscribes to events on a given document.
 // If an error occurs before stream initialization, the second response, error,
 // is returned. If the context "ctx" is canceled or timed out, returned channel
 // is closed, and "WatchResponse" from this closed channel has zero events and
 // nil "Err()".
+//
+// If the agent does not support the watch stream, the documents are watched
+// with WatchDocuments, and they can not be changed by AddWatch or RemoveWatch.
 func (c *Client) Watch(
 	ctx context.Context,
 	docs ...*document.Document,
@@ -371,14 +678,16 @@ func (c *Client) Watch(
 	}
 
 	rch := make(chan WatchResponse)
-	stream, err := c.client.WatchDocuments(ctx, &api.WatchDocumentsRequest{
-		Client: converter.ToClient(types.Client{
-			ID:           c.id,
-			MetadataInfo: c.metadataInfo,
-		}),
-		DocumentKeys: converter.ToDocumentKeys(keys),
-	})
+	watchCtx, cancel := context.WithCancel(ctx)
+	stream, pbResp, err := c.openWatchStream(watchCtx, keys)
+	if IsTokenExpired(err) {
+		// NOTE: The token is refreshed by the interceptor when the stream is
+		//       rejected with the expired token, so the stream is reopened
+		//       once with the new token.
+		stream, pbResp, err = c.openWatchStream(watchCtx, keys)
+	}
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
@@ -397,7 +706,10 @@ func (c *Client) Watch(
 				}
 			}
 
-			return nil, nil
+			return &WatchResponse{
+				Type:          PeersChanged,
+				PeersMapByDoc: c.PeersMapByDoc(),
+			}, nil
 		case *api.WatchDocumentsResponse_Event:
 			eventType, err := converter.FromEventType(resp.Event.Type)
 			if err != nil {
@@ -406,17 +718,39 @@ func (c *Client) Watch(
 
 			switch eventType {
 			case types.DocumentsChangedEvent:
+				var pack *change.Pack
+				if resp.Event.ChangePack != nil {
+					if pack, err = converter.FromChangePack(resp.Event.ChangePack); err != nil {
+						return nil, err
+					}
+				}
+
+				return &WatchResponse{
+					Type:       DocumentsChanged,
+					Keys:       converter.FromDocumentKeys(resp.Event.DocumentKeys),
+					ChangePack: pack,
+				}, nil
+			case types.BroadcastEvent:
+				publisher, err := converter.FromClient(resp.Event.Publisher)
+				if err != nil {
+					return nil, err
+				}
+
 				return &WatchResponse{
-					Type: DocumentsChanged,
-					Keys: converter.FromDocumentKeys(resp.Event.DocumentKeys),
+					Type:      Broadcasted,
+					Keys:      converter.FromDocumentKeys(resp.Event.DocumentKeys),
+					Publisher: publisher.ID.String(),
+					Payload:   resp.Event.Payload,
 				}, nil
 			case types.DocumentsWatchedEvent, types.DocumentsUnwatchedEvent, types.MetadataChangedEvent:
-				for _, k := range converter.FromDocumentKeys(resp.Event.DocumentKeys) {
-					cli, err := converter.FromClient(resp.Event.Publisher)
-					if err != nil {
-						return nil, err
-					}
+				keys := converter.FromDocumentKeys(resp.Event.DocumentKeys)
+				publisher, err := converter.FromClient(resp.Event.Publisher)
+				if err != nil {
+					return nil, err
+				}
 
+				for _, k := range keys {
+					cli := *publisher
 					attachment := c.attachments[k.BSONKey()]
 					if eventType == types.DocumentsWatchedEvent ||
 						eventType == types.MetadataChangedEvent {
@@ -431,30 +765,32 @@ func (c *Client) Watch(
 				return &WatchResponse{
 					Type:          PeersChanged,
 					PeersMapByDoc: c.PeersMapByDoc(),
+					PeerChange:    newPeerChange(eventType, publisher, keys),
 				}, nil
 			}
 		}
 		return nil, ErrUnsupportedWatchResponseType
 	}
 
-	pbResp, err := stream.Recv()
-	if err != nil {
-		return nil, err
-	}
 	if _, err := handleResponse(pbResp); err != nil {
+		cancel()
 		return nil, err
 	}
+	c.startWatch(stream, keys)
 
 	go func() {
+		defer cancel()
 		for {
 			pbResp, err := stream.Recv()
 			if err != nil {
+				c.endWatch(stream)
 				rch <- WatchResponse{Err: err}
 				close(rch)
 				return
 			}
 			resp, err := handleResponse(pbResp)
 			if err != nil {
+				c.endWatch(stream)
 				rch <- WatchResponse{Err: err}
 				close(rch)
 				return
@@ -466,6 +802,251 @@ func (c *Client) Watch(
 	return rch, nil
 }
 
+// openWatchStream opens the stream to watch the given documents and returns it
+// with the first response. If the agent does not support WatchDocumentsStream,
+// it falls back to WatchDocuments, whose documents can not be changed.
+func (c *Client) openWatchStream(
+	ctx context.Context,
+	keys []*key.Key,
+) (watchStream, *api.WatchDocumentsResponse, error) {
+	stream, pbResp, err := c.openBidiWatchStream(ctx, keys)
+	if grpcstatus.Code(err) == codes.Unimplemented {
+		return c.openLegacyWatchStream(ctx, keys)
+	}
+	return stream, pbResp, err
+}
+
+// openBidiWatchStream opens the stream of WatchDocumentsStream to watch the
+// given documents and returns it with the first response.
+func (c *Client) openBidiWatchStream(
+	ctx context.Context,
+	keys []*key.Key,
+) (watchStream, *api.WatchDocumentsResponse, error) {
+	stream, err := c.client.WatchDocumentsStream(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := stream.Send(&api.WatchDocumentsStreamRequest{
+		Client: converter.ToClient(types.Client{
+			ID:           c.id,
+			MetadataInfo: c.metadataInfo,
+		}),
+		AddedDocumentKeys: converter.ToDocumentKeys(keys),
+		IncludeChanges:    c.watchChanges,
+	}); err != nil && err != io.EOF {
+		// NOTE: io.EOF means the stream is aborted by the agent, and the
+		//       status of it, such as Unimplemented, is returned by Recv.
+		return nil, nil, err
+	}
+
+	pbResp, err := stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stream, pbResp, nil
+}
+
+// openLegacyWatchStream opens the stream of WatchDocuments to watch the given
+// documents and returns it with the first response.
+func (c *Client) openLegacyWatchStream(
+	ctx context.Context,
+	keys []*key.Key,
+) (watchStream, *api.WatchDocumentsResponse, error) {
+	stream, err := c.client.WatchDocuments(ctx, &api.WatchDocumentsRequest{
+		Client: converter.ToClient(types.Client{
+			ID:           c.id,
+			MetadataInfo: c.metadataInfo,
+		}),
+		DocumentKeys:   converter.ToDocumentKeys(keys),
+		IncludeChanges: c.watchChanges,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pbResp, err := stream.Recv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stream, pbResp, nil
+}
+
+// WatchChanges tails the changes of the given collection stored after the
+// given cursor, across documents. The collection is required, and if the
+// cursor is empty, changes are delivered from the beginning. It does not
+// require activation.
+//
+// Changes are delivered at least once, so the consumer should store the cursor
+// of each change after processing it and ignore the changes already processed
+// by their document key and server seq.
+func (c *Client) WatchChanges(
+	ctx context.Context,
+	collection string,
+	cursor string,
+) (<-chan CapturedChange, error) {
+	stream, err := c.client.WatchChanges(ctx, &api.WatchChangesRequest{
+		Collection: collection,
+		Cursor:     cursor,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	handleResponse := func(pbResp *api.WatchChangesResponse) (*CapturedChange, error) {
+		docKey, err := converter.FromDocumentKey(pbResp.DocumentKey)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := converter.FromChange(pbResp.Change)
+		if err != nil {
+			return nil, err
+		}
+		c.SetServerSeq(pbResp.ServerSeq)
+
+		return &CapturedChange{
+			DocumentKey: docKey,
+			ServerSeq:   pbResp.ServerSeq,
+			Change:      c,
+			Cursor:      pbResp.Cursor,
+		}, nil
+	}
+
+	rch := make(chan CapturedChange)
+	go func() {
+		for {
+			pbResp, err := stream.Recv()
+			if err != nil {
+				rch <- CapturedChange{Err: err}
+				close(rch)
+				return
+			}
+			resp, err := handleResponse(pbResp)
+			if err != nil {
+				rch <- CapturedChange{Err: err}
+				close(rch)
+				return
+			}
+			rch <- *resp
+		}
+	}()
+
+	return rch, nil
+}
+
+// AddWatch adds the given documents to the live watch without reopening the
+// stream. Peers of the added documents are delivered as PeersChanged. It
+// returns ErrWatchStreamNotSupported if the watch is opened on an agent
+// without the watch stream.
+func (c *Client) AddWatch(docs ...*document.Document) error {
+	return c.updateWatch(docs, nil)
+}
+
+// RemoveWatch removes the given documents from the live watch without
+// reopening the stream. The watch ends when all the documents are removed.
+func (c *Client) RemoveWatch(docs ...*document.Document) error {
+	return c.updateWatch(nil, docs)
+}
+
+func (c *Client) updateWatch(added, removed []*document.Document) error {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+
+	var addedKeys []*key.Key
+	for _, doc := range added {
+		if _, ok := c.attachments[doc.Key().BSONKey()]; !ok {
+			return ErrDocumentNotAttached
+		}
+		addedKeys = append(addedKeys, doc.Key())
+	}
+	if len(addedKeys) > 0 {
+		if c.watchStream == nil {
+			return ErrWatchNotStarted
+		}
+		stream, ok := c.watchStream.(api.Yorkie_WatchDocumentsStreamClient)
+		if !ok {
+			return ErrWatchStreamNotSupported
+		}
+		if err := stream.Send(&api.WatchDocumentsStreamRequest{
+			AddedDocumentKeys: converter.ToDocumentKeys(addedKeys),
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		c.setWatchStream(addedKeys, stream)
+	}
+
+	// NOTE: The documents are removed from the streams of the watches that
+	//       watch them, which can be other than the latest one.
+	removedKeysByStream := make(map[watchStream][]*key.Key)
+	for _, doc := range removed {
+		attachment, ok := c.attachments[doc.Key().BSONKey()]
+		if !ok || attachment.watchStream == nil {
+			continue
+		}
+		removedKeysByStream[attachment.watchStream] = append(
+			removedKeysByStream[attachment.watchStream],
+			doc.Key(),
+		)
+	}
+	if len(removed) > 0 && len(removedKeysByStream) == 0 {
+		return ErrWatchNotStarted
+	}
+	for s, removedKeys := range removedKeysByStream {
+		stream, ok := s.(api.Yorkie_WatchDocumentsStreamClient)
+		if !ok {
+			return ErrWatchStreamNotSupported
+		}
+		if err := stream.Send(&api.WatchDocumentsStreamRequest{
+			RemovedDocumentKeys: converter.ToDocumentKeys(removedKeys),
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		c.setWatchStream(removedKeys, nil)
+	}
+
+	return nil
+}
+
+// startWatch sets the given stream as the stream of the latest watch and the
+// stream that watches the documents of the given keys.
+func (c *Client) startWatch(stream watchStream, keys []*key.Key) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+
+	c.watchStream = stream
+	c.setWatchStream(keys, stream)
+}
+
+// endWatch clears the given stream from this client and the documents watched
+// by it, so that the ended stream is no longer used.
+func (c *Client) endWatch(stream watchStream) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+
+	if c.watchStream == stream {
+		c.watchStream = nil
+	}
+	for _, attachment := range c.attachments {
+		if attachment.watchStream == stream {
+			attachment.watchStream = nil
+		}
+	}
+}
+
+// setWatchStream sets the stream that watches the documents of the given keys.
+func (c *Client) setWatchStream(keys []*key.Key, stream watchStream) {
+	for _, k := range keys {
+		if attachment, ok := c.attachments[k.BSONKey()]; ok {
+			attachment.watchStream = stream
+		}
+	}
+}
+
 // UpdateMetadata updates the metadata of this client.
 func (c *Client) UpdateMetadata(ctx context.Context, k, v string) error {
 	if c.status != activated {
@@ -475,13 +1056,19 @@ func (c *Client) UpdateMetadata(ctx context.Context, k, v string) error {
 	c.metadataInfo.Data[k] = v
 	c.metadataInfo.Clock++
 
-	if len(c.attachments) == 0 {
-		return nil
-	}
-
+	// NOTE: The agent only accepts the metadata of the watchers of the
+	//       documents. The metadata is sent to the others when they are
+	//       watched.
 	var keys []*key.Key
+	c.watchMu.Lock()
 	for _, attachment := range c.attachments {
-		keys = append(keys, attachment.doc.Key())
+		if attachment.watchStream != nil {
+			keys = append(keys, attachment.doc.Key())
+		}
+	}
+	c.watchMu.Unlock()
+	if len(keys) == 0 {
+		return nil
 	}
 
 	// TODO(hackerwins): We temporarily use Unary Call to update metadata,
@@ -501,6 +1088,52 @@ func (c *Client) UpdateMetadata(ctx context.Context, k, v string) error {
 	return nil
 }
 
+// Broadcast sends the given transient message to the peers watching the given
+// documents. The message is not stored as a change of the documents.
+func (c *Client) Broadcast(
+	ctx context.Context,
+	payload []byte,
+	docs ...*document.Document,
+) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	var keys []*key.Key
+	for _, doc := range docs {
+		if _, ok := c.attachments[doc.Key().BSONKey()]; !ok {
+			return ErrDocumentNotAttached
+		}
+		keys = append(keys, doc.Key())
+	}
+
+	if _, err := c.client.Broadcast(ctx, &api.BroadcastRequest{
+		Client: converter.ToClient(types.Client{
+			ID:           c.id,
+			MetadataInfo: c.metadataInfo,
+		}),
+		DocumentKeys: converter.ToDocumentKeys(keys),
+		Payload:      payload,
+	}); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePresence updates the presence of this client with the given
+// JSON-serializable value. The value is stored in the metadata under the key
+// of types.PresenceKey.
+func (c *Client) UpdatePresence(ctx context.Context, k string, v interface{}) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateMetadata(ctx, types.PresenceKey(k), string(encoded))
+}
+
 // ID returns the ID of this client.
 func (c *Client) ID() *time.ActorID {
 	return c.id
@@ -534,6 +1167,21 @@ func (c *Client) PeersMapByDoc() map[string]map[string]types.Metadata {
 	return peersMapByDoc
 }
 
+// PeerPresence returns the presence of the given peer in the given document.
+func (c *Client) PeerPresence(doc *document.Document, peerID string) (types.Presence, error) {
+	attachment, ok := c.attachments[doc.Key().BSONKey()]
+	if !ok {
+		return nil, ErrDocumentNotAttached
+	}
+
+	info, ok := attachment.peers[peerID]
+	if !ok {
+		return nil, ErrPeerNotFound
+	}
+
+	return info.Data.Presence(), nil
+}
+
 // IsActive returns whether this client is active or not.
 func (c *Client) IsActive() bool {
 	return c.status == activated
@@ -549,14 +1197,25 @@ func (c *Client) sync(ctx context.Context, key *key.Key) error {
 		return ErrDocumentNotAttached
 	}
 
-	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
+	if attachment.syncMode == SyncModePaused {
+		return nil
+	}
+
+	reqPack := attachment.doc.CreateChangePack()
+	if attachment.syncMode == SyncModePullOnly {
+		reqPack = change.NewPack(reqPack.DocumentKey, attachment.doc.Checkpoint(), nil, nil)
+	}
+
+	pbChangePack, err := converter.ToChangePack(reqPack)
 	if err != nil {
 		return err
 	}
 
 	res, err := c.client.PushPull(ctx, &api.PushPullRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
+		ClientId:             c.id.Bytes(),
+		ChangePack:           pbChangePack,
+		PushOnly:             attachment.syncMode == SyncModePushOnly,
+		SnapshotCompressions: snapshotCompressions,
 	})
 	if err != nil {
 		log.Logger.Error(err)
@@ -568,6 +1227,11 @@ func (c *Client) sync(ctx context.Context, key *key.Key) error {
 		return err
 	}
 
+	// NOTE: Changes pushed in push-only mode are pulled back once the document
+	// pulls again. They are already applied to the local document, so they
+	// are skipped.
+	pack.Changes = c.filterRemoteChanges(pack.Changes)
+
 	if err := attachment.doc.ApplyChangePack(pack); err != nil {
 		log.Logger.Error(err)
 		return err
@@ -575,3 +1239,44 @@ func (c *Client) sync(ctx context.Context, key *key.Key) error {
 
 	return nil
 }
+
+// filterRemoteChanges returns the given changes excluding changes made by
+// this client.
+func (c *Client) filterRemoteChanges(changes []*change.Change) []*change.Change {
+	var remoteChanges []*change.Change
+	for _, cn := range changes {
+		if cn.ID().Actor().Compare(c.id) == 0 {
+			continue
+		}
+		remoteChanges = append(remoteChanges, cn)
+	}
+	return remoteChanges
+}
+
+// newPeerChange creates a PeerChange of the given publisher from the given
+// event type.
+func newPeerChange(
+	eventType types.DocEventType,
+	publisher *types.Client,
+	keys []*key.Key,
+) *PeerChange {
+	peerChange := &PeerChange{
+		PeerID: publisher.ID.String(),
+		Keys:   keys,
+	}
+
+	switch eventType {
+	case types.DocumentsWatchedEvent:
+		peerChange.Type = PeerJoined
+	case types.DocumentsUnwatchedEvent:
+		peerChange.Type = PeerLeft
+	case types.MetadataChangedEvent:
+		peerChange.Type = PeerPresenceChanged
+	}
+
+	if eventType != types.DocumentsUnwatchedEvent {
+		peerChange.Presence = publisher.MetadataInfo.Data.Presence()
+	}
+
+	return peerChange
+}
@@ -1,15 +1,88 @@
 package client_test
 
 import (
+	"context"
+	"net"
 	"testing"
 
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
 )
 
+// legacyAgent is an agent without WatchDocumentsStream.
+type legacyAgent struct {
+	api.UnimplementedYorkieServer
+}
+
+func (a *legacyAgent) ActivateClient(
+	_ context.Context,
+	_ *api.ActivateClientRequest,
+) (*api.ActivateClientResponse, error) {
+	return &api.ActivateClientResponse{ClientId: time.InitialActorID.Bytes()}, nil
+}
+
+func (a *legacyAgent) DeactivateClient(
+	_ context.Context,
+	req *api.DeactivateClientRequest,
+) (*api.DeactivateClientResponse, error) {
+	return &api.DeactivateClientResponse{ClientId: req.ClientId}, nil
+}
+
+func (a *legacyAgent) AttachDocument(
+	_ context.Context,
+	req *api.AttachDocumentRequest,
+) (*api.AttachDocumentResponse, error) {
+	pack, err := converter.FromChangePack(req.ChangePack)
+	if err != nil {
+		return nil, err
+	}
+	pbPack, err := converter.ToChangePack(&change.Pack{
+		DocumentKey:     pack.DocumentKey,
+		Checkpoint:      checkpoint.Initial,
+		MinSyncedTicket: time.InitialTicket,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.AttachDocumentResponse{ChangePack: pbPack}, nil
+}
+
+func (a *legacyAgent) WatchDocuments(
+	req *api.WatchDocumentsRequest,
+	stream api.Yorkie_WatchDocumentsServer,
+) error {
+	if err := stream.Send(&api.WatchDocumentsResponse{
+		Body: &api.WatchDocumentsResponse_Initialization_{
+			Initialization: &api.WatchDocumentsResponse_Initialization{},
+		},
+	}); err != nil {
+		return err
+	}
+	if err := stream.Send(&api.WatchDocumentsResponse{
+		Body: &api.WatchDocumentsResponse_Event{
+			Event: &api.DocEvent{
+				Type:         api.DocEventType_DOCUMENTS_CHANGED,
+				Publisher:    req.Client,
+				DocumentKeys: req.DocumentKeys,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
 func TestClient(t *testing.T) {
 	t.Run("create instance test", func(t *testing.T) {
 		opts := client.Option{
@@ -21,4 +94,38 @@ func TestClient(t *testing.T) {
 
 		assert.Equal(t, opts.Metadata, cli.Metadata())
 	})
+
+	t.Run("watch against agent without watch stream test", func(t *testing.T) {
+		lis, err := net.Listen("tcp", "localhost:0")
+		assert.NoError(t, err)
+		server := grpc.NewServer()
+		api.RegisterYorkieServer(server, &legacyAgent{})
+		go func() {
+			_ = server.Serve(lis)
+		}()
+		defer server.Stop()
+
+		ctx := context.Background()
+		cli, err := client.Dial(lis.Addr().String())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.Close())
+		}()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(t.Name(), "doc")
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := cli.Watch(watchCtx, doc)
+		assert.NoError(t, err)
+
+		resp := <-rch
+		assert.NoError(t, resp.Err)
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+		assert.Equal(t, doc.Key().BSONKey(), resp.Keys[0].BSONKey())
+
+		assert.ErrorIs(t, cli.AddWatch(doc), client.ErrWatchStreamNotSupported)
+	})
 }
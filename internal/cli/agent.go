@@ -43,6 +43,10 @@ var (
 	authWebhookMaxWaitInterval time.Duration
 	authWebhookCacheAuthTTL    time.Duration
 	authWebhookCacheUnauthTTL  time.Duration
+	authWebhookRequestTimeout  time.Duration
+
+	changeHookMaxWaitInterval time.Duration
+	changeHookRequestTimeout  time.Duration
 
 	etcdEndpoints     []string
 	etcdDialTimeout   time.Duration
@@ -50,6 +54,12 @@ var (
 	etcdPassword      string
 	etcdLockLeaseTime time.Duration
 
+	etcdClusterCAFile     string
+	etcdClusterCertFile   string
+	etcdClusterKeyFile    string
+	etcdClusterSecretFile string
+	etcdClusterInsecure   bool
+
 	conf = yorkie.NewConfig()
 )
 
@@ -63,6 +73,9 @@ func newAgentCmd() *cobra.Command {
 			conf.Backend.AuthWebhookMaxWaitInterval = authWebhookMaxWaitInterval.String()
 			conf.Backend.AuthWebhookCacheAuthTTL = authWebhookCacheAuthTTL.String()
 			conf.Backend.AuthWebhookCacheUnauthTTL = authWebhookCacheUnauthTTL.String()
+			conf.Backend.AuthWebhookRequestTimeout = authWebhookRequestTimeout.String()
+			conf.Backend.ChangeHookMaxWaitInterval = changeHookMaxWaitInterval.String()
+			conf.Backend.ChangeHookRequestTimeout = changeHookRequestTimeout.String()
 
 			if etcdEndpoints != nil {
 				conf.ETCD = &etcd.Config{
@@ -71,6 +84,12 @@ func newAgentCmd() *cobra.Command {
 					Username:      etcdUsername,
 					Password:      etcdPassword,
 					LockLeaseTime: etcdLockLeaseTime.String(),
+
+					ClusterCAFile:     etcdClusterCAFile,
+					ClusterCertFile:   etcdClusterCertFile,
+					ClusterKeyFile:    etcdClusterKeyFile,
+					ClusterSecretFile: etcdClusterSecretFile,
+					ClusterInsecure:   etcdClusterInsecure,
 				}
 			}
 
@@ -170,12 +189,62 @@ func init() {
 		"",
 		"RPC key file's path",
 	)
+	cmd.Flags().StringVar(
+		&conf.RPC.ClientCAFile,
+		"rpc-client-ca-file",
+		"",
+		"Path of the CA file to verify RPC client certificates."+
+			" Clients with verified certificates are authenticated by their subjects.",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.RPC.InternalSubjects,
+		"rpc-internal-subjects",
+		nil,
+		"Comma separated list of client certificate subjects of internal services,"+
+			" which are granted all accesses without tokens and can call the admin service.",
+	)
 	cmd.Flags().Uint64Var(
 		&conf.RPC.MaxRequestBytes,
 		"rpc-max-requests-bytes",
 		yorkie.DefaultRPCMaxRequestsBytes,
 		"Maximum client request size in bytes the server will accept.",
 	)
+	cmd.Flags().Float64Var(
+		&conf.RPC.ClientRateLimit,
+		"rpc-client-rate-limit",
+		0,
+		"Number of RPC calls per second allowed for a client, identified by its client ID. 0 disables the limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.RPC.ClientRateBurst,
+		"rpc-client-rate-burst",
+		0,
+		"Maximum number of RPC calls a client can make at once.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.RPC.TokenRateLimit,
+		"rpc-token-rate-limit",
+		0,
+		"Number of RPC calls per second allowed for an auth token. 0 disables the limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.RPC.TokenRateBurst,
+		"rpc-token-rate-burst",
+		0,
+		"Maximum number of RPC calls with an auth token at once.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.RPC.DocumentRateLimit,
+		"rpc-document-rate-limit",
+		0,
+		"Number of RPC calls per second allowed for a document. 0 disables the limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.RPC.DocumentRateBurst,
+		"rpc-document-rate-burst",
+		0,
+		"Maximum number of RPC calls for a document at once.",
+	)
 	cmd.Flags().IntVar(
 		&conf.Profiling.Port,
 		"profiling-port",
@@ -242,6 +311,38 @@ func init() {
 		etcd.DefaultLockLeaseTime,
 		"ETCD's lease time for lock",
 	)
+	cmd.Flags().StringVar(
+		&etcdClusterCAFile,
+		"etcd-cluster-ca-file",
+		"",
+		"Path of the CA file shared by the agents of the cluster to call each other with TLS",
+	)
+	cmd.Flags().StringVar(
+		&etcdClusterCertFile,
+		"etcd-cluster-cert-file",
+		"",
+		"Path of the certificate file presented to the other agents of the cluster",
+	)
+	cmd.Flags().StringVar(
+		&etcdClusterKeyFile,
+		"etcd-cluster-key-file",
+		"",
+		"Path of the key file of the certificate presented to the other agents of the cluster",
+	)
+	cmd.Flags().StringVar(
+		&etcdClusterSecretFile,
+		"etcd-cluster-secret-file",
+		"",
+		"Path of the secret file shared by the agents of the cluster to authenticate each other."+
+			" It requires --etcd-cluster-ca-file",
+	)
+	cmd.Flags().BoolVar(
+		&etcdClusterInsecure,
+		"etcd-cluster-insecure",
+		false,
+		"Whether to accept the cluster requests by the addresses of the members, without"+
+			" the cluster secret or certificates. Enable it only in trusted networks.",
+	)
 	cmd.Flags().Uint64Var(
 		&conf.Backend.SnapshotThreshold,
 		"backend-snapshot-threshold",
@@ -255,6 +356,66 @@ func init() {
 		yorkie.DefaultSnapshotInterval,
 		"Interval of changes to create a snapshot",
 	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotCompression,
+		"backend-snapshot-compression",
+		yorkie.DefaultSnapshotCompression,
+		"Compression of snapshots: none, gzip or zstd. Enable it only after every agent is upgraded",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.DocCacheSize,
+		"backend-doc-cache-size",
+		yorkie.DefaultDocCacheSize,
+		"Max number of documents kept in memory to create snapshots. 0 disables the cache.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SchemaFile,
+		"backend-schema-file",
+		"",
+		"Path of the JSON file that maps collections to the schemas of their documents",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxOperationsPerChange,
+		"backend-max-operations-per-change",
+		0,
+		"Max number of operations in a change. 0 means no limit.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxChangesPerPack,
+		"backend-max-changes-per-pack",
+		0,
+		"Max number of changes in a pack. 0 means no limit.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxDocumentSize,
+		"backend-max-document-size",
+		0,
+		"Max size of a document in bytes. 0 means no limit.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxTextLength,
+		"backend-max-text-length",
+		0,
+		"Max length of a text in a document. 0 means no limit.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxArrayLength,
+		"backend-max-array-length",
+		0,
+		"Max number of elements of an array in a document. 0 means no limit.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxAttachedClientsPerDocument,
+		"backend-max-attached-clients-per-document",
+		0,
+		"Max number of clients that attach a document. 0 means no limit.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.AuditLogEnabled,
+		"backend-audit-log-enabled",
+		false,
+		"Whether to record the access to documents in the audit log.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.AuthWebhookURL,
 		"auth-webhook-url",
@@ -292,6 +453,123 @@ func init() {
 		yorkie.DefaultAuthWebhookCacheUnauthTTL,
 		"TTL value to set when caching unauthorized webhook response.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxBroadcastPayloadSize,
+		"backend-max-broadcast-payload-size",
+		yorkie.DefaultMaxBroadcastPayloadSize,
+		"Max size of the payload of a broadcast message in bytes.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.AuthWebhookCacheSize,
+		"auth-webhook-cache-size",
+		yorkie.DefaultAuthWebhookCacheSize,
+		"Max number of the decisions of the authorization webhook cached per token and document key.",
+	)
+	cmd.Flags().DurationVar(
+		&authWebhookRequestTimeout,
+		"auth-webhook-request-timeout",
+		yorkie.DefaultAuthWebhookRequestTimeout,
+		"Time limit of a request to the authorization webhook.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookSigningSecretFile,
+		"auth-webhook-signing-secret-file",
+		"",
+		"Path of the secret file to sign authorization webhook requests with HMAC-SHA256."+
+			" If no value is specified, requests are not signed.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookCAFile,
+		"auth-webhook-ca-file",
+		"",
+		"Path of the PEM file of the CA certificates to verify the authorization webhook server.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookCertFile,
+		"auth-webhook-cert-file",
+		"",
+		"Path of the PEM file of the client certificate presented to the authorization webhook server.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookKeyFile,
+		"auth-webhook-key-file",
+		"",
+		"Path of the PEM file of the key of the client certificate.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthJWTHMACSecretFile,
+		"auth-jwt-hmac-secret-file",
+		"",
+		"Path of the HMAC secret file to verify JWTs locally instead of the authorization webhook.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthJWTPublicKeyFile,
+		"auth-jwt-public-key-file",
+		"",
+		"Path of the PEM file of the RSA or ECDSA public key to verify JWTs.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthJWTJWKSFile,
+		"auth-jwt-jwks-file",
+		"",
+		"Path of the JWKS file of the keys to verify JWTs.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthJWTIssuer,
+		"auth-jwt-issuer",
+		"",
+		"Expected issuer of JWTs. If no value is specified, the issuer is not checked.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthJWTAudience,
+		"auth-jwt-audience",
+		"",
+		"Expected audience of JWTs. If no value is specified, the audience is not checked.",
+	)
+	cmd.Flags().StringToStringVar(
+		&conf.Backend.ChangeHookURLs,
+		"change-hook-urls",
+		map[string]string{},
+		"URLs of change hooks by collection, called when documents of the collection are changed."+
+			" e.g. --change-hook-urls todos=http://localhost:8080/hook",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.ChangeHookIncludeOperations,
+		"change-hook-include-operations",
+		false,
+		"Whether to include the operations of changes in change hook requests.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.ChangeHookMaxRetries,
+		"change-hook-max-retries",
+		yorkie.DefaultChangeHookMaxRetries,
+		"Maximum number of retries for a change hook.",
+	)
+	cmd.Flags().DurationVar(
+		&changeHookMaxWaitInterval,
+		"change-hook-max-wait-interval",
+		yorkie.DefaultChangeHookMaxWaitInterval,
+		"Maximum wait interval for change hook.",
+	)
+	cmd.Flags().DurationVar(
+		&changeHookRequestTimeout,
+		"change-hook-request-timeout",
+		yorkie.DefaultChangeHookRequestTimeout,
+		"Time limit of a request to the change hook.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.ChangeHookQueueSize,
+		"change-hook-queue-size",
+		yorkie.DefaultChangeHookQueueSize,
+		"Maximum number of change hook requests waiting to be sent per collection.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.ChangeHookSigningSecretFile,
+		"change-hook-signing-secret-file",
+		"",
+		"Path of the secret file to sign change hook requests with HMAC-SHA256."+
+			" If no value is specified, requests are not signed.",
+	)
 
 	rootCmd.AddCommand(cmd)
 }
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+)
+
+var (
+	auditMongoConf = &mongo.Config{}
+
+	auditMongoConnectionTimeout time.Duration
+	auditMongoPingTimeout       time.Duration
+
+	auditFilter = &db.AuditLogFilter{}
+	auditSince  string
+	auditUntil  string
+)
+
+func newAuditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "audit [options]",
+		Short: "Queries the audit log of the access to documents",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auditMongoConf.ConnectionTimeout = auditMongoConnectionTimeout.String()
+			auditMongoConf.PingTimeout = auditMongoPingTimeout.String()
+			if err := auditMongoConf.Validate(); err != nil {
+				return err
+			}
+
+			var err error
+			if auditFilter.Since, err = parseAuditTime("since", auditSince); err != nil {
+				return err
+			}
+			if auditFilter.Until, err = parseAuditTime("until", auditUntil); err != nil {
+				return err
+			}
+
+			client, err := mongo.Dial(auditMongoConf)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := client.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			logs, err := client.FindAuditLogs(context.Background(), auditFilter)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CREATED AT\tSUBJECT\tCLIENT ID\tMETHOD\tDOCUMENT\tOPERATIONS\tDENIED")
+			for _, l := range logs {
+				fmt.Fprintf(
+					w,
+					"%s\t%s\t%s\t%s\t%s\t%d\t%t\n",
+					l.CreatedAt.Format(time.RFC3339Nano),
+					l.Subject,
+					l.ClientID,
+					l.Method,
+					l.DocKey,
+					l.Operations,
+					l.Denied,
+				)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// parseAuditTime parses the given value of the flag of the given name in
+// RFC3339. The empty value is parsed as the zero time.
+func parseAuditTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid argument \"%s\" for \"--%s\" flag: %w", value, name, err)
+	}
+
+	return t, nil
+}
+
+func init() {
+	cmd := newAuditCmd()
+	cmd.Flags().DurationVar(
+		&auditMongoConnectionTimeout,
+		"mongo-connection-timeout",
+		yorkie.DefaultMongoConnectionTimeout,
+		"Mongo DB's connection timeout",
+	)
+	cmd.Flags().StringVar(
+		&auditMongoConf.ConnectionURI,
+		"mongo-connection-uri",
+		yorkie.DefaultMongoConnectionURI,
+		"MongoDB's connection URI",
+	)
+	cmd.Flags().StringVar(
+		&auditMongoConf.YorkieDatabase,
+		"mongo-yorkie-database",
+		yorkie.DefaultMongoYorkieDatabase,
+		"Yorkie's database name in MongoDB",
+	)
+	cmd.Flags().DurationVar(
+		&auditMongoPingTimeout,
+		"mongo-ping-timeout",
+		yorkie.DefaultMongoPingTimeout,
+		"Mongo DB's ping timeout",
+	)
+	cmd.Flags().StringVar(
+		&auditFilter.Subject,
+		"subject",
+		"",
+		"Subject authenticated by the requests",
+	)
+	cmd.Flags().StringVar(
+		(*string)(&auditFilter.ClientID),
+		"client-id",
+		"",
+		"ID of the client",
+	)
+	cmd.Flags().StringVar(
+		&auditFilter.Method,
+		"method",
+		"",
+		"RPC method such as AttachDocument, DetachDocument, PushPull, WatchDocuments, FetchDocument and WatchChanges",
+	)
+	cmd.Flags().StringVar(
+		&auditFilter.DocKey,
+		"document",
+		"",
+		"Key of the document in the form of \"collection$document\", or \"collection$*\" for WatchChanges",
+	)
+	cmd.Flags().BoolVar(
+		&auditFilter.DeniedOnly,
+		"denied",
+		false,
+		"Whether to query only the entries of the denied accesses",
+	)
+	cmd.Flags().StringVar(
+		&auditSince,
+		"since",
+		"",
+		"Time in RFC3339 since which the entries are queried, e.g. 2021-10-01T00:00:00Z",
+	)
+	cmd.Flags().StringVar(
+		&auditUntil,
+		"until",
+		"",
+		"Time in RFC3339 until which the entries are queried (exclusive)",
+	)
+	cmd.Flags().Int64Var(
+		&auditFilter.Limit,
+		"limit",
+		100,
+		"Max number of the entries to query. 0 means no limit.",
+	)
+
+	rootCmd.AddCommand(cmd)
+}
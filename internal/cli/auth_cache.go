@@ -0,0 +1,207 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+var (
+	// errPurgeFailed is returned when the auth cache of any agent is not
+	// purged.
+	errPurgeFailed = errors.New("failed to purge the auth cache of some agents")
+
+	purgeRPCAddrs           []string
+	purgeCAFile             string
+	purgeServerNameOverride string
+	purgeClientCertFile     string
+	purgeClientKeyFile      string
+	purgeTimeout            time.Duration
+	purgeRequest            = &api.PurgeAuthCacheRequest{}
+	purgeDocument           string
+)
+
+func newPurgeAuthCacheCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-auth-cache [options]",
+		Short: "Purges the cached decisions of the authorization webhook in the agents",
+		Long: "Purges the cached decisions of the authorization webhook in the agents, " +
+			"so that the revoked accesses are applied before the decisions expire. " +
+			"The decisions are selected by the token, the subject and the document, " +
+			"and all of them are purged if no filter is given. Each agent purges " +
+			"the caches of all the members of its cluster, and the agents verify " +
+			"the client certificate of the command.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if purgeDocument != "" {
+				docKey, err := key.FromBSONKey(purgeDocument)
+				if err != nil {
+					return fmt.Errorf("invalid argument \"%s\" for \"--document\" flag: %w", purgeDocument, err)
+				}
+				purgeRequest.DocumentKey = converter.ToDocumentKey(docKey)
+			}
+
+			creds, err := newAdminCredentials()
+			if err != nil {
+				return err
+			}
+
+			failed := false
+			for _, rpcAddr := range purgeRPCAddrs {
+				purged, err := purgeAuthCache(rpcAddr, creds)
+				if err != nil {
+					log.Logger.Errorf("%s: %s", rpcAddr, err.Error())
+					failed = true
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: %d entries purged\n", rpcAddr, purged)
+			}
+
+			if failed {
+				return errPurgeFailed
+			}
+			return nil
+		},
+	}
+}
+
+// newAdminCredentials creates the TLS credentials with the client certificate
+// to authenticate the command to the agents.
+func newAdminCredentials() (credentials.TransportCredentials, error) {
+	tlsConfig := &tls.Config{ServerName: purgeServerNameOverride}
+	if purgeCAFile != "" {
+		caCerts, err := ioutil.ReadFile(filepath.Clean(purgeCAFile))
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("%s: no certificate", purgeCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	cert, err := tls.LoadX509KeyPair(purgeClientCertFile, purgeClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// purgeAuthCache purges the auth cache of the agent of the given address, and
+// returns the number of the purged decisions.
+func purgeAuthCache(rpcAddr string, creds credentials.TransportCredentials) (int32, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), purgeTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, rpcAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	resp, err := api.NewAdminClient(conn).PurgeAuthCache(ctx, purgeRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.PurgedCount, nil
+}
+
+func init() {
+	cmd := newPurgeAuthCacheCmd()
+	cmd.Flags().StringSliceVar(
+		&purgeRPCAddrs,
+		"rpc-addr",
+		[]string{fmt.Sprintf("localhost:%d", yorkie.DefaultRPCPort)},
+		"Addresses of the agents. Any agent of a cluster purges the caches of all its members.",
+	)
+	cmd.Flags().StringVar(
+		&purgeCAFile,
+		"ca-file",
+		"",
+		"CA certificate file to verify the agents. The system CAs are used if it is empty.",
+	)
+	cmd.Flags().StringVar(
+		&purgeServerNameOverride,
+		"server-name-override",
+		"",
+		"Server name to verify the certificates of the agents",
+	)
+	cmd.Flags().StringVar(
+		&purgeClientCertFile,
+		"client-cert-file",
+		"",
+		"Client certificate file to authenticate the command to the agents",
+	)
+	cmd.Flags().StringVar(
+		&purgeClientKeyFile,
+		"client-key-file",
+		"",
+		"Key file of the client certificate",
+	)
+	cmd.Flags().DurationVar(
+		&purgeTimeout,
+		"timeout",
+		10*time.Second,
+		"Time limit of the request to each agent",
+	)
+	cmd.Flags().StringVar(
+		&purgeRequest.Token,
+		"token",
+		"",
+		"Token of the decisions to purge",
+	)
+	cmd.Flags().StringVar(
+		&purgeRequest.Subject,
+		"subject",
+		"",
+		"Subject returned by the webhook with the decisions to purge",
+	)
+	cmd.Flags().StringVar(
+		&purgeDocument,
+		"document",
+		"",
+		"Key of the document of the decisions to purge in the form of \"collection$document\"",
+	)
+	_ = cmd.MarkFlagRequired("client-cert-file")
+	_ = cmd.MarkFlagRequired("client-key-file")
+
+	rootCmd.AddCommand(cmd)
+}
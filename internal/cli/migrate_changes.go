@@ -0,0 +1,200 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
+)
+
+var (
+	migrateMongoConf = &mongo.Config{}
+
+	migrateMongoConnectionTimeout time.Duration
+	migrateMongoPingTimeout       time.Duration
+
+	migrateETCDConf = &etcd.Config{}
+
+	migrateETCDDialTimeout   time.Duration
+	migrateETCDLockLeaseTime time.Duration
+)
+
+func newMigrateChangesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-changes [options]",
+		Short: "Migrates the changes stored by the previous versions to be delivered by WatchChanges",
+		Long: "Migrates the changes stored by the previous versions to be delivered by WatchChanges. " +
+			"The changes are given the seqs after the changes stored so far, so they are delivered " +
+			"after the cursors already handed out. Run it after all the agents are upgraded. It " +
+			"takes the locks of the changes of the collections from etcd, so the agents of a " +
+			"cluster can keep running, while the agent without etcd should be stopped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrateMongoConf.ConnectionTimeout = migrateMongoConnectionTimeout.String()
+			migrateMongoConf.PingTimeout = migrateMongoPingTimeout.String()
+			if err := migrateMongoConf.Validate(); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			lock := func(collection string) (func(), error) {
+				return func() {}, nil
+			}
+			if migrateETCDConf.Endpoints != nil {
+				migrateETCDConf.DialTimeout = migrateETCDDialTimeout.String()
+				migrateETCDConf.LockLeaseTime = migrateETCDLockLeaseTime.String()
+				if err := migrateETCDConf.Validate(); err != nil {
+					return err
+				}
+
+				etcdCli, err := etcd.Dial(migrateETCDConf, &sync.AgentInfo{ID: xid.New().String()})
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := etcdCli.Close(); err != nil {
+						log.Logger.Error(err)
+					}
+				}()
+
+				lock = func(collection string) (func(), error) {
+					locker, err := etcdCli.NewLocker(ctx, packs.NewChangesKey(collection))
+					if err != nil {
+						return nil, err
+					}
+					if err := locker.Lock(ctx); err != nil {
+						return nil, err
+					}
+
+					return func() {
+						if err := locker.Unlock(ctx); err != nil {
+							log.Logger.Error(err)
+						}
+					}, nil
+				}
+			}
+
+			client, err := mongo.Dial(migrateMongoConf)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := client.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			docInfos, err := client.FindDocInfosOfLegacyChanges(ctx)
+			if err != nil {
+				return err
+			}
+
+			migrated := 0
+			for _, docInfo := range docInfos {
+				docKey, err := key.FromBSONKey(docInfo.Key)
+				if err != nil {
+					return err
+				}
+
+				unlock, err := lock(docKey.Collection)
+				if err != nil {
+					return err
+				}
+				count, err := client.MigrateChangeInfos(ctx, docInfo)
+				unlock()
+				if err != nil {
+					return err
+				}
+
+				migrated += count
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d changes of %d documents migrated\n", migrated, len(docInfos))
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newMigrateChangesCmd()
+	cmd.Flags().DurationVar(
+		&migrateMongoConnectionTimeout,
+		"mongo-connection-timeout",
+		yorkie.DefaultMongoConnectionTimeout,
+		"Mongo DB's connection timeout",
+	)
+	cmd.Flags().StringVar(
+		&migrateMongoConf.ConnectionURI,
+		"mongo-connection-uri",
+		yorkie.DefaultMongoConnectionURI,
+		"MongoDB's connection URI",
+	)
+	cmd.Flags().StringVar(
+		&migrateMongoConf.YorkieDatabase,
+		"mongo-yorkie-database",
+		yorkie.DefaultMongoYorkieDatabase,
+		"Yorkie's database name in MongoDB",
+	)
+	cmd.Flags().DurationVar(
+		&migrateMongoPingTimeout,
+		"mongo-ping-timeout",
+		yorkie.DefaultMongoPingTimeout,
+		"Mongo DB's ping timeout",
+	)
+	cmd.Flags().StringSliceVar(
+		&migrateETCDConf.Endpoints,
+		"etcd-endpoints",
+		nil,
+		"Comma separated list of etcd endpoints of the cluster",
+	)
+	cmd.Flags().DurationVar(
+		&migrateETCDDialTimeout,
+		"etcd-dial-timeout",
+		etcd.DefaultDialTimeout,
+		"ETCD's dial timeout",
+	)
+	cmd.Flags().StringVar(
+		&migrateETCDConf.Username,
+		"etcd-username",
+		"",
+		"ETCD's user name",
+	)
+	cmd.Flags().StringVar(
+		&migrateETCDConf.Password,
+		"etcd-password",
+		"",
+		"ETCD's password",
+	)
+	cmd.Flags().DurationVar(
+		&migrateETCDLockLeaseTime,
+		"etcd-lock-lease-time",
+		etcd.DefaultLockLeaseTime,
+		"ETCD's lease time for lock",
+	)
+	rootCmd.AddCommand(cmd)
+}
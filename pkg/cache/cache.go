@@ -72,7 +72,7 @@ func (c *LRUExpireCache) Add(
 	if ok {
 		c.evictionList.MoveToFront(oldElement)
 		oldElement.Value.(*cacheEntry).value = value
-		oldElement.Value.(*cacheEntry).expireTime = time.Now()
+		oldElement.Value.(*cacheEntry).expireTime = time.Now().Add(ttl)
 		return
 	}
 
@@ -111,3 +111,43 @@ func (c *LRUExpireCache) Get(key string) (interface{}, bool) {
 
 	return element.Value.(*cacheEntry).value, true
 }
+
+// Remove removes the value at the specified key from the cache.
+func (c *LRUExpireCache) Remove(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	c.evictionList.Remove(element)
+	delete(c.entries, key)
+}
+
+// RemoveAll removes the values that match the given predicate from the cache,
+// and returns the number of the removed values. The expired values are also
+// removed, but not counted.
+func (c *LRUExpireCache) RemoveAll(predicate func(key string, value interface{}) bool) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	removed := 0
+	now := time.Now()
+	for key, element := range c.entries {
+		entry := element.Value.(*cacheEntry)
+		expired := now.After(entry.expireTime)
+		if !expired && !predicate(key, entry.value) {
+			continue
+		}
+
+		c.evictionList.Remove(element)
+		delete(c.entries, key)
+		if !expired {
+			removed++
+		}
+	}
+
+	return removed
+}
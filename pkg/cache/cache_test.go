@@ -40,6 +40,57 @@ func TestCache(t *testing.T) {
 		assert.Nil(t, response1)
 	})
 
+	t.Run("update test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache(1)
+		assert.NoError(t, err)
+
+		lruCache.Add("request", "response1", time.Second)
+		lruCache.Add("request", "response2", time.Second)
+		response, ok := lruCache.Get("request")
+		assert.True(t, ok)
+		assert.Equal(t, "response2", response)
+	})
+
+	t.Run("remove test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache(1)
+		assert.NoError(t, err)
+
+		lruCache.Add("request", "response", time.Second)
+		lruCache.Remove("request")
+		response, ok := lruCache.Get("request")
+		assert.False(t, ok)
+		assert.Nil(t, response)
+
+		// removing a missing key is a no-op
+		lruCache.Remove("request")
+	})
+
+	t.Run("remove all test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache(3)
+		assert.NoError(t, err)
+
+		lruCache.Add("request1", "response1", time.Second)
+		lruCache.Add("request2", "response2", time.Second)
+		lruCache.Add("request3", "response1", time.Second)
+		removed := lruCache.RemoveAll(func(key string, value interface{}) bool {
+			return value == "response1"
+		})
+		assert.Equal(t, 2, removed)
+
+		_, ok := lruCache.Get("request1")
+		assert.False(t, ok)
+		_, ok = lruCache.Get("request3")
+		assert.False(t, ok)
+		response, ok := lruCache.Get("request2")
+		assert.True(t, ok)
+		assert.Equal(t, "response2", response)
+
+		// the removed entries make room for the new ones
+		lruCache.Add("request4", "response4", time.Second)
+		_, ok = lruCache.Get("request2")
+		assert.True(t, ok)
+	})
+
 	t.Run("get expired cache test", func(t *testing.T) {
 		lruCache, err := cache.NewLRUExpireCache(1)
 		assert.NoError(t, err)
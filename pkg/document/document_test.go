@@ -41,6 +41,30 @@ func TestDocument(t *testing.T) {
 		assert.False(t, doc.IsAttached())
 	})
 
+	t.Run("deep copy test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		internal := document.NewInternalDocument("c1", "d1")
+		assert.NoError(t, internal.ApplyChangePack(doc.CreateChangePack()))
+
+		copied := internal.DeepCopy()
+		assert.Equal(t, internal.Marshal(), copied.Marshal())
+		assert.Equal(t, internal.Checkpoint(), copied.Checkpoint())
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, copied.ApplyChangePack(doc.CreateChangePack()))
+		assert.Equal(t, `{"k1":"v1"}`, internal.Marshal())
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, copied.Marshal())
+	})
+
 	t.Run("status test", func(t *testing.T) {
 		doc := document.New("c1", "d1")
 		assert.False(t, doc.IsAttached())
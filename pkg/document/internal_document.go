@@ -89,6 +89,17 @@ func (d *InternalDocument) Key() *key.Key {
 	return d.key
 }
 
+// DeepCopy copies this document deeply. The local changes are not copied.
+func (d *InternalDocument) DeepCopy() *InternalDocument {
+	return &InternalDocument{
+		key:        d.key,
+		status:     d.status,
+		root:       d.root.DeepCopy(),
+		checkpoint: d.checkpoint,
+		changeID:   d.changeID,
+	}
+}
+
 // Checkpoint returns the checkpoint of this document.
 func (d *InternalDocument) Checkpoint() *checkpoint.Checkpoint {
 	return d.checkpoint
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 )
 
 // VerbType represents an action taken on the document.
@@ -43,6 +44,10 @@ var (
 	ErrInvalidWebhookResponse = errors.New("invalid authorization webhook response")
 )
 
+// TokenExpiredReason is the reason of the error details that the agent attaches
+// to the Unauthenticated errors of the expired tokens.
+const TokenExpiredReason = "TOKEN_EXPIRED"
+
 // Method represents a method name of RPC.
 type Method string
 
@@ -54,6 +59,14 @@ const (
 	DetachDocument   Method = "DetachDocument"
 	PushPull         Method = "PushPull"
 	WatchDocuments   Method = "WatchDocuments"
+	UpdateMetadata   Method = "UpdateMetadata"
+	FetchDocument    Method = "FetchDocument"
+	Broadcast        Method = "Broadcast"
+
+	// WatchChanges reads the changes of all the documents of a collection,
+	// so it is requested with the key of the form "collection$*", which is
+	// matched by the permissions of the collection such as "todos$*".
+	WatchChanges Method = "WatchChanges"
 )
 
 // IsAuthMethod returns whether the given method can be used for authorization.
@@ -75,6 +88,10 @@ func AuthMethods() []Method {
 		DetachDocument,
 		PushPull,
 		WatchDocuments,
+		UpdateMetadata,
+		FetchDocument,
+		Broadcast,
+		WatchChanges,
 	}
 }
 
@@ -95,6 +112,10 @@ type AuthWebhookRequest struct {
 	Token      string            `json:"token"`
 	Method     Method            `json:"method"`
 	Attributes []AccessAttribute `json:"attributes"`
+
+	// CertificateSubject is the subject of the verified client certificate
+	// of the request. It is empty if the request has no certificate.
+	CertificateSubject string `json:"certificateSubject,omitempty"`
 }
 
 // NewAuthWebhookRequest creates a new instance of AuthWebhookRequest.
@@ -112,6 +133,27 @@ func NewAuthWebhookRequest(reader io.Reader) (*AuthWebhookRequest, error) {
 type AuthWebhookResponse struct {
 	Allowed bool   `json:"allowed"`
 	Reason  string `json:"reason"`
+
+	// Permissions is the verbs granted on the documents whose keys match the
+	// glob patterns of the keys. If it is empty, the requested attributes are
+	// granted as they are. For example, a response that allows the access with
+	// only Read on the requested document lets the client attach it and pull
+	// the changes, but its changes are not pushed.
+	Permissions []AccessAttribute `json:"permissions,omitempty"`
+
+	// Subject is the identity of the owner of the token. The clients activated
+	// with the subject can only be used by the same subject.
+	Subject string `json:"subject,omitempty"`
+
+	// Expired tells that the access is not allowed because the token has
+	// expired. The agent reports it to the client with TokenExpiredReason, so
+	// that the client refreshes its token instead of retrying with it.
+	Expired bool `json:"expired,omitempty"`
+
+	// CacheTTL is how long the agent caches this response, such as "30s". If
+	// it is empty, the TTL of the agent configuration is used, and "0s" means
+	// that this response is not cached.
+	CacheTTL string `json:"cacheTTL,omitempty"`
 }
 
 // NewAuthWebhookResponse creates a new instance of AuthWebhookResponse.
@@ -122,9 +164,32 @@ func NewAuthWebhookResponse(reader io.Reader) (*AuthWebhookResponse, error) {
 		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidWebhookResponse)
 	}
 
+	if _, _, err := resp.ParseCacheTTL(); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
+// ParseCacheTTL returns the TTL to cache this response. The second result is
+// false if the TTL is not specified by the webhook. It returns
+// ErrInvalidWebhookResponse if the TTL is invalid or negative.
+func (r *AuthWebhookResponse) ParseCacheTTL() (time.Duration, bool, error) {
+	if r.CacheTTL == "" {
+		return 0, false, nil
+	}
+
+	ttl, err := time.ParseDuration(r.CacheTTL)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: %w", err.Error(), ErrInvalidWebhookResponse)
+	}
+	if ttl < 0 {
+		return 0, false, fmt.Errorf("negative cache TTL %s: %w", r.CacheTTL, ErrInvalidWebhookResponse)
+	}
+
+	return ttl, true, nil
+}
+
 // Write writes this response to the given writer.
 func (r *AuthWebhookResponse) Write(writer io.Writer) (int, error) {
 	resBody, err := json.Marshal(r)
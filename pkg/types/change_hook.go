@@ -0,0 +1,60 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidChangeHookRequest is returned when the given change hook request is not valid.
+var ErrInvalidChangeHookRequest = errors.New("invalid change hook request")
+
+// ChangeHookRequest represents the request of change hook. It is sent when
+// the changes of a document are stored.
+type ChangeHookRequest struct {
+	DocumentKey   string             `json:"document_key"`
+	Actor         string             `json:"actor"`
+	FromServerSeq uint64             `json:"from_server_seq"`
+	ToServerSeq   uint64             `json:"to_server_seq"`
+	Changes       []ChangeHookChange `json:"changes,omitempty"`
+}
+
+// ChangeHookChange represents a change included in the change hook request.
+type ChangeHookChange struct {
+	ServerSeq uint64 `json:"server_seq"`
+	ClientSeq uint32 `json:"client_seq"`
+	Lamport   uint64 `json:"lamport"`
+	Message   string `json:"message"`
+
+	// Operations are the operations of the change encoded in the JSON
+	// representation of the protobuf messages.
+	Operations []json.RawMessage `json:"operations"`
+}
+
+// NewChangeHookRequest creates a new instance of ChangeHookRequest.
+func NewChangeHookRequest(reader io.Reader) (*ChangeHookRequest, error) {
+	req := &ChangeHookRequest{}
+
+	if err := json.NewDecoder(reader).Decode(req); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidChangeHookRequest)
+	}
+
+	return req, nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -35,6 +36,60 @@ func (c *Client) Marshal() (string, error) {
 // Metadata represents custom metadata that can be defined in the client.
 type Metadata map[string]string
 
+// PresenceKeyPrefix is the prefix of the metadata keys of the presence. The
+// values of the keys are JSON-encoded, while the other values are plain
+// strings.
+const PresenceKeyPrefix = "presence:"
+
+// PresenceKey returns the metadata key of the given presence key.
+func PresenceKey(k string) string {
+	return PresenceKeyPrefix + k
+}
+
+// Presence returns the presence of the metadata. The values of the presence
+// keys are kept as JSON, and the plain values are encoded as JSON strings.
+// The presence keys precede the plain keys of the same name.
+func (m Metadata) Presence() Presence {
+	presence := make(Presence)
+	for k, v := range m {
+		if strings.HasPrefix(k, PresenceKeyPrefix) {
+			continue
+		}
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		presence[k] = encoded
+	}
+
+	for k, v := range m {
+		if !strings.HasPrefix(k, PresenceKeyPrefix) || !json.Valid([]byte(v)) {
+			continue
+		}
+		presence[strings.TrimPrefix(k, PresenceKeyPrefix)] = json.RawMessage(v)
+	}
+	return presence
+}
+
+// Presence represents the presence of a peer. Each value is JSON-encoded so
+// that arbitrary JSON-serializable values can be shared with peers.
+type Presence map[string]json.RawMessage
+
+// Decode decodes the value of the given key into v. It returns false if the
+// presence does not have the key.
+func (p Presence) Decode(k string, v interface{}) (bool, error) {
+	value, ok := p[k]
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(value, v); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
 // MetadataInfo is a metadata information with logical clock.
 type MetadataInfo struct {
 	Clock int32
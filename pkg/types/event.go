@@ -18,4 +18,8 @@ const (
 
 	// MetadataChangedEvent is an event indicating that metadata is changed.
 	MetadataChangedEvent DocEventType = "metadata-changed"
+
+	// BroadcastEvent is an event indicating that a peer broadcasts a transient
+	// message. The message is not stored as a change.
+	BroadcastEvent DocEventType = "broadcast"
 )
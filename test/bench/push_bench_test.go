@@ -0,0 +1,87 @@
+//go:build bench
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+func BenchmarkPush(b *testing.B) {
+	b.Run("push to 1000 elements with cache test", func(b *testing.B) {
+		benchmarkPush(1000, helper.DocCacheSize, b)
+	})
+
+	b.Run("push to 1000 elements without cache test", func(b *testing.B) {
+		benchmarkPush(1000, 0, b)
+	})
+
+	b.Run("push to 10000 elements with cache test", func(b *testing.B) {
+		benchmarkPush(10000, helper.DocCacheSize, b)
+	})
+
+	b.Run("push to 10000 elements without cache test", func(b *testing.B) {
+		benchmarkPush(10000, 0, b)
+	})
+}
+
+// benchmarkPush measures the pushes of a change to the document of the given
+// number of elements. Every push is validated on the document.
+func benchmarkPush(cnt int, docCacheSize uint64, b *testing.B) {
+	conf := helper.TestConfig("")
+	conf.Backend.DocCacheSize = docCacheSize
+	agent, err := yorkie.New(conf)
+	assert.NoError(b, err)
+	assert.NoError(b, agent.Start())
+	defer func() { assert.NoError(b, agent.Shutdown(true)) }()
+
+	ctx := context.Background()
+	cli, err := client.Dial(agent.RPCAddr())
+	assert.NoError(b, err)
+	defer func() { assert.NoError(b, cli.Close()) }()
+	assert.NoError(b, cli.Activate(ctx))
+
+	doc := document.New(helper.Collection, b.Name())
+	assert.NoError(b, cli.Attach(ctx, doc))
+	assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+		for i := 0; i < cnt; i++ {
+			root.SetInteger(fmt.Sprintf("k%d", i), i)
+		}
+		return nil
+	}))
+	assert.NoError(b, cli.Sync(ctx))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		assert.NoError(b, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("k0", i)
+			return nil
+		}))
+		assert.NoError(b, cli.Sync(ctx))
+	}
+}
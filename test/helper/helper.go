@@ -43,10 +43,18 @@ const (
 	MongoConnectionTimeout     = "5s"
 	MongoPingTimeout           = "5s"
 	SnapshotThreshold          = 10
+	SnapshotCompression        = "zstd"
+	DocCacheSize               = 10
 	Collection                 = "test-collection"
 	AuthWebhookMaxWaitInterval = 3 * gotime.Millisecond
 	AuthWebhookCacheAuthTTL    = 10 * gotime.Second
 	AuthWebhookCacheUnauthTTL  = 10 * gotime.Second
+	AuthWebhookCacheSize       = 5000
+	MaxBroadcastPayloadSize    = 64 * 1024
+	AuthWebhookRequestTimeout  = 10 * gotime.Second
+	ChangeHookMaxWaitInterval  = 3 * gotime.Millisecond
+	ChangeHookRequestTimeout   = 10 * gotime.Second
+	ChangeHookQueueSize        = 100
 	ETCDDialTimeout            = 5 * gotime.Second
 	ETCDLockLeaseTime          = 30 * gotime.Second
 )
@@ -96,10 +104,18 @@ func TestConfig(authWebhook string) *yorkie.Config {
 		},
 		Backend: &backend.Config{
 			SnapshotThreshold:          SnapshotThreshold,
+			SnapshotCompression:        SnapshotCompression,
+			DocCacheSize:               DocCacheSize,
 			AuthWebhookURL:             authWebhook,
 			AuthWebhookMaxWaitInterval: AuthWebhookMaxWaitInterval.String(),
 			AuthWebhookCacheAuthTTL:    AuthWebhookCacheAuthTTL.String(),
 			AuthWebhookCacheUnauthTTL:  AuthWebhookCacheUnauthTTL.String(),
+			AuthWebhookCacheSize:       AuthWebhookCacheSize,
+			MaxBroadcastPayloadSize:    MaxBroadcastPayloadSize,
+			AuthWebhookRequestTimeout:  AuthWebhookRequestTimeout.String(),
+			ChangeHookMaxWaitInterval:  ChangeHookMaxWaitInterval.String(),
+			ChangeHookRequestTimeout:   ChangeHookRequestTimeout.String(),
+			ChangeHookQueueSize:        ChangeHookQueueSize,
 		},
 		Mongo: &mongo.Config{
 			ConnectionURI:     MongoConnectionURI,
@@ -111,6 +127,9 @@ func TestConfig(authWebhook string) *yorkie.Config {
 			Endpoints:     ETCDEndpoints,
 			DialTimeout:   ETCDDialTimeout.String(),
 			LockLeaseTime: ETCDLockLeaseTime.String(),
+
+			// NOTE: The agents of the tests run on the same host.
+			ClusterInsecure: true,
 		},
 	}
 }
@@ -0,0 +1,149 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Run("audit log test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			res := types.AuthWebhookResponse{Allowed: req.Token != "mallory", Subject: req.Token}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuditLogEnabled = true
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "alice"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// 01. attach, update, sync without changes and detach the document.
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Sync(ctx))
+		assert.NoError(t, cli.Detach(ctx, doc))
+
+		// 02. fetch the document and tail the changes of the collection.
+		_, err = cli.Fetch(ctx, helper.Collection, t.Name())
+		assert.NoError(t, err)
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		rch, err := cli.WatchChanges(watchCtx, helper.Collection, "")
+		assert.NoError(t, err)
+		receiveCapturedChange(t, rch)
+		cancel()
+
+		// 03. fetch the document with the token without permissions.
+		malloryCli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "mallory"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, malloryCli.Close()) }()
+		_, err = malloryCli.Fetch(ctx, helper.Collection, t.Name())
+		assert.Error(t, err)
+
+		// 04. the accesses are recorded except the sync without changes.
+		mongoCli, err := mongo.Dial(conf.Mongo)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, mongoCli.Close()) }()
+
+		logs, err := mongoCli.FindAuditLogs(ctx, &db.AuditLogFilter{
+			DocKey: doc.Key().BSONKey(),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, logs, 5)
+
+		var methods []string
+		for _, l := range logs[:3] {
+			assert.Equal(t, "alice", l.Subject)
+			assert.Equal(t, cli.ID().String(), l.ClientID.String())
+			assert.False(t, l.Denied)
+			methods = append(methods, l.Method)
+		}
+		assert.Equal(t, []string{
+			string(types.AttachDocument),
+			string(types.PushPull),
+			string(types.DetachDocument),
+		}, methods)
+		assert.Equal(t, 2, logs[1].Operations)
+
+		// 05. the read accesses are recorded without the client.
+		assert.Equal(t, string(types.FetchDocument), logs[3].Method)
+		assert.Equal(t, "alice", logs[3].Subject)
+		assert.False(t, logs[3].Denied)
+
+		logs, err = mongoCli.FindAuditLogs(ctx, &db.AuditLogFilter{
+			Subject: "alice",
+			Method:  string(types.WatchChanges),
+			DocKey:  helper.Collection + "$*",
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, logs)
+
+		// 06. the denied access is recorded.
+		logs, err = mongoCli.FindAuditLogs(ctx, &db.AuditLogFilter{
+			DocKey:     doc.Key().BSONKey(),
+			DeniedOnly: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, logs, 1)
+		assert.Equal(t, string(types.FetchDocument), logs[0].Method)
+		assert.True(t, logs[0].Denied)
+
+		// 07. the entries are filtered by the subject.
+		logs, err = mongoCli.FindAuditLogs(ctx, &db.AuditLogFilter{
+			Subject: "bob",
+			DocKey:  doc.Key().BSONKey(),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, logs, 0)
+	})
+}
@@ -0,0 +1,246 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+// dialAdmin dials the agent of the given address with the given client
+// certificate, and returns the admin client.
+func dialAdmin(t *testing.T, rpcAddr string, ca *certificate, cert *certificate) (api.AdminClient, func()) {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	keyPair, err := tls.LoadX509KeyPair(cert.certFile, cert.keyFile)
+	assert.NoError(t, err)
+
+	conn, err := grpc.Dial(rpcAddr, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{keyPair},
+	})))
+	assert.NoError(t, err)
+
+	return api.NewAdminClient(conn), func() { assert.NoError(t, conn.Close()) }
+}
+
+func TestAuthCache(t *testing.T) {
+	t.Run("per document cache test", func(t *testing.T) {
+		var watchReqs []*types.AuthWebhookRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+			if req.Method == types.WatchDocuments {
+				watchReqs = append(watchReqs, req)
+			}
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watch := func(docNames ...string) {
+			cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "token"})
+			assert.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, cli.Close()) })
+			assert.NoError(t, cli.Activate(ctx))
+
+			var docs []*document.Document
+			for _, name := range docNames {
+				doc := document.New(helper.Collection, t.Name()+name)
+				assert.NoError(t, cli.Attach(ctx, doc))
+				docs = append(docs, doc)
+			}
+			_, err = cli.Watch(ctx, docs...)
+			assert.NoError(t, err)
+		}
+
+		// 01. the documents watched together are sent to the webhook together.
+		watch("d1", "d2")
+		assert.Len(t, watchReqs, 1)
+		assert.Len(t, watchReqs[0].Attributes, 2)
+
+		// 02. the documents in another order hit the cache.
+		watch("d2", "d1")
+		assert.Len(t, watchReqs, 1)
+
+		// 03. only the documents missing in the cache are sent to the webhook.
+		watch("d1", "d3")
+		assert.Len(t, watchReqs, 2)
+		assert.Equal(t, []types.AccessAttribute{{
+			Key:  helper.Collection + "$" + t.Name() + "d3",
+			Verb: types.Read,
+		}}, watchReqs[1].Attributes)
+	})
+
+	t.Run("webhook cache TTL test", func(t *testing.T) {
+		reqCnt := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+			if req.Method == types.PushPull {
+				reqCnt++
+			}
+
+			// the response is not cached by its TTL.
+			res := types.AuthWebhookResponse{Allowed: true, CacheTTL: "0s"}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "token"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewObject("k1")
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+		assert.Equal(t, 3, reqCnt)
+	})
+
+	t.Run("purge cache test", func(t *testing.T) {
+		revoked := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			res := types.AuthWebhookResponse{Allowed: !revoked, Subject: "alice"}
+			if revoked {
+				res.Reason = "revoked"
+			}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		ca, serverCert, adminCert := issueCertificates(t, "yorkie-admin")
+		userCert := issueCertificate(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "yorkie-user"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, ca)
+		conf := helper.TestConfig(server.URL)
+		conf.RPC.CertFile = serverCert.certFile
+		conf.RPC.KeyFile = serverCert.keyFile
+		conf.RPC.ClientCAFile = ca.certFile
+		conf.RPC.InternalSubjects = []string{"CN=yorkie-admin"}
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "token", CertFile: ca.certFile})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 01. the revoked access is still allowed by the cached decisions.
+		revoked = true
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. the admin requests without the client certificate are denied.
+		caPool := x509.NewCertPool()
+		caPool.AddCert(ca.cert)
+		connWithoutCert, err := grpc.Dial(agent.RPCAddr(), grpc.WithTransportCredentials(
+			credentials.NewClientTLSFromCert(caPool, ""),
+		))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, connWithoutCert.Close()) }()
+		_, err = api.NewAdminClient(connWithoutCert).PurgeAuthCache(
+			metadata.AppendToOutgoingContext(ctx, "authorization", "token"),
+			&api.PurgeAuthCacheRequest{},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// the admin requests with the certificate of a subject other than
+		// the internal subjects are also denied.
+		user, closeUser := dialAdmin(t, agent.RPCAddr(), ca, userCert)
+		defer closeUser()
+		_, err = user.PurgeAuthCache(
+			metadata.AppendToOutgoingContext(ctx, "authorization", "token"),
+			&api.PurgeAuthCacheRequest{},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 03. the decisions on other documents are not purged.
+		admin, closeAdmin := dialAdmin(t, agent.RPCAddr(), ca, adminCert)
+		defer closeAdmin()
+		resp, err := admin.PurgeAuthCache(ctx, &api.PurgeAuthCacheRequest{
+			DocumentKey: converter.ToDocumentKey(&key.Key{Collection: helper.Collection, Document: "others"}),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), resp.PurgedCount)
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 04. the revoked access is denied after the decisions of the subject
+		// are purged.
+		resp, err = admin.PurgeAuthCache(ctx, &api.PurgeAuthCacheRequest{Subject: "alice"})
+		assert.NoError(t, err)
+		assert.Greater(t, resp.PurgedCount, int32(0))
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,377 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+)
+
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, permissions ...types.AccessAttribute) string {
+	return signTokenOf(t, "", method, key, permissions...)
+}
+
+func signTokenOf(
+	t *testing.T,
+	subject string,
+	method jwt.SigningMethod,
+	key interface{},
+	permissions ...types.AccessAttribute,
+) string {
+	return signTokenExpiresIn(t, subject, time.Hour, method, key, permissions...)
+}
+
+func signTokenExpiresIn(
+	t *testing.T,
+	subject string,
+	expiresIn time.Duration,
+	method jwt.SigningMethod,
+	key interface{},
+	permissions ...types.AccessAttribute,
+) string {
+	token, err := jwt.NewWithClaims(method, auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    "yorkie-test",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+		},
+		Permissions: permissions,
+	}).SignedString(key)
+	assert.NoError(t, err)
+	return token
+}
+
+func TestAuthJWT(t *testing.T) {
+	t.Run("HMAC JWT test", func(t *testing.T) {
+		secret := []byte("yorkie-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthJWTHMACSecretFile = secretFile
+		conf.Backend.AuthJWTIssuer = "yorkie-test"
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+
+		// client with a token that permits the documents of the collection
+		token := signToken(t, jwt.SigningMethodHS256, secret, types.AccessAttribute{
+			Key:  helper.Collection + "$*",
+			Verb: types.ReadWrite,
+		})
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: token})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// client with a token that does not permit the document
+		tokenForOthers := signToken(t, jwt.SigningMethodHS256, secret, types.AccessAttribute{
+			Key:  "others$*",
+			Verb: types.ReadWrite,
+		})
+		cliForOthers, err := client.Dial(agent.RPCAddr(), client.Option{Token: tokenForOthers})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliForOthers.Close()) }()
+		assert.NoError(t, cliForOthers.Activate(ctx))
+		defer func() { assert.NoError(t, cliForOthers.Deactivate(ctx)) }()
+		err = cliForOthers.Attach(ctx, document.New(helper.Collection, t.Name()))
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+		// client with a token signed with another secret
+		invalidToken := signToken(t, jwt.SigningMethodHS256, []byte("invalid"))
+		cliWithInvalidToken, err := client.Dial(agent.RPCAddr(), client.Option{Token: invalidToken})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithInvalidToken.Close()) }()
+		err = cliWithInvalidToken.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+		// client without token
+		cliWithoutToken, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithoutToken.Close()) }()
+		err = cliWithoutToken.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("keys without kid test", func(t *testing.T) {
+		secret := []byte("yorkie-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		jwksSecret := []byte("yorkie-jwks-secret")
+		jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+		assert.NoError(t, ioutil.WriteFile(jwksFile, []byte(`{"keys": [{"kty": "oct", "k": "`+
+			base64.RawURLEncoding.EncodeToString(jwksSecret)+`"}]}`), 0600))
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthJWTHMACSecretFile = secretFile
+		conf.Backend.AuthJWTJWKSFile = jwksFile
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+
+		// 01. the token is verified with the second key without kid.
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{
+			Token: signToken(t, jwt.SigningMethodHS256, jwksSecret),
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		// 02. the token without exp is rejected.
+		tokenWithoutExp, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "alice"},
+		}).SignedString(secret)
+		assert.NoError(t, err)
+		cliWithoutExp, err := client.Dial(agent.RPCAddr(), client.Option{Token: tokenWithoutExp})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithoutExp.Close()) }()
+		err = cliWithoutExp.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("ECDSA JWT test", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		assert.NoError(t, err)
+		publicKeyFile := filepath.Join(t.TempDir(), "public.pem")
+		assert.NoError(t, ioutil.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{
+			Type:  "PUBLIC KEY",
+			Bytes: publicKey,
+		}), 0600))
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthJWTPublicKeyFile = publicKeyFile
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+
+		// client with a token that only permits to read the document
+		docKey := helper.Collection + "$" + t.Name()
+		token := signToken(t, jwt.SigningMethodES256, privateKey, types.AccessAttribute{
+			Key:  docKey,
+			Verb: types.Read,
+		})
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: token})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// the changes pushed with the token are rejected
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// HMAC token signed with the public key should be rejected
+		hmacToken := signToken(t, jwt.SigningMethodHS256, pem.EncodeToMemory(&pem.Block{
+			Type:  "PUBLIC KEY",
+			Bytes: publicKey,
+		}))
+		cliWithHMACToken, err := client.Dial(agent.RPCAddr(), client.Option{Token: hmacToken})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithHMACToken.Close()) }()
+		err = cliWithHMACToken.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("client subject test", func(t *testing.T) {
+		secret := []byte("yorkie-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthJWTHMACSecretFile = secretFile
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		permission := types.AccessAttribute{Key: helper.Collection + "$*", Verb: types.ReadWrite}
+		aliceToken := signTokenOf(t, "alice", jwt.SigningMethodHS256, secret, permission)
+		bobToken := signTokenOf(t, "bob", jwt.SigningMethodHS256, secret, permission)
+
+		// 01. alice activates the client bound to alice.
+		clientKey := t.Name()
+		alice, err := client.Dial(agent.RPCAddr(), client.Option{Key: clientKey, Token: aliceToken})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, alice.Close()) }()
+		assert.NoError(t, alice.Activate(ctx))
+		defer func() { assert.NoError(t, alice.Deactivate(ctx)) }()
+
+		// 02. bob can not activate the client of alice.
+		bob, err := client.Dial(agent.RPCAddr(), client.Option{Key: clientKey, Token: bobToken})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, bob.Close()) }()
+		err = bob.Activate(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 03. bob can not use the client ID of alice.
+		conn, err := grpc.Dial(agent.RPCAddr(), grpc.WithInsecure())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		_, err = api.NewYorkieClient(conn).DeactivateClient(
+			metadata.AppendToOutgoingContext(ctx, "authorization", bobToken),
+			&api.DeactivateClientRequest{ClientId: alice.ID().Bytes()},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 04. the client activated without a subject is bound to alice who
+		// uses it first, so bob can not use it.
+		unbound, err := client.Dial(defaultAgent.RPCAddr(), client.Option{Key: t.Name() + "-unbound"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, unbound.Close()) }()
+		assert.NoError(t, unbound.Activate(ctx))
+
+		_, err = api.NewYorkieClient(conn).DeactivateClient(
+			metadata.AppendToOutgoingContext(ctx, "authorization", aliceToken),
+			&api.DeactivateClientRequest{ClientId: unbound.ID().Bytes()},
+		)
+		assert.NoError(t, err)
+		_, err = api.NewYorkieClient(conn).DeactivateClient(
+			metadata.AppendToOutgoingContext(ctx, "authorization", bobToken),
+			&api.DeactivateClientRequest{ClientId: unbound.ID().Bytes()},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 05. the client of alice can not be activated without a subject.
+		anonymous, err := client.Dial(defaultAgent.RPCAddr(), client.Option{Key: clientKey})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, anonymous.Close()) }()
+		err = anonymous.Activate(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+	t.Run("token refresh test", func(t *testing.T) {
+		secret := []byte("yorkie-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthJWTHMACSecretFile = secretFile
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		permission := types.AccessAttribute{Key: helper.Collection + "$*", Verb: types.ReadWrite}
+
+		// the provider issues the tokens that expire in two seconds, and counts
+		// the refreshes requested by the client.
+		var token string
+		refreshCnt := 0
+		provider := func(ctx context.Context, expired bool) (string, error) {
+			if token == "" || expired {
+				if expired {
+					refreshCnt++
+				}
+				token = signTokenExpiresIn(t, "alice", 2*time.Second, jwt.SigningMethodHS256, secret, permission)
+			}
+			return token, nil
+		}
+
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{TokenProvider: provider})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. the expired token is rejected with the distinct error.
+		time.Sleep(3 * time.Second)
+		conn, err := grpc.Dial(agent.RPCAddr(), grpc.WithInsecure())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		_, err = api.NewYorkieClient(conn).DeactivateClient(
+			metadata.AppendToOutgoingContext(ctx, "authorization", token),
+			&api.DeactivateClientRequest{ClientId: cli.ID().Bytes()},
+		)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		assert.True(t, client.IsTokenExpired(err))
+
+		// 02. the unary RPC is retried with the refreshed token.
+		assert.NoError(t, cli.Sync(ctx))
+		assert.Equal(t, 1, refreshCnt)
+
+		// 03. the watch stream is reopened with the refreshed token.
+		time.Sleep(3 * time.Second)
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		_, err = cli.Watch(watchCtx, doc)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, refreshCnt)
+
+		// 04. the token that is not valid is not refreshed.
+		invalidToken := signToken(t, jwt.SigningMethodHS256, []byte("invalid"))
+		_, err = api.NewYorkieClient(conn).DeactivateClient(
+			metadata.AppendToOutgoingContext(ctx, "authorization", invalidToken),
+			&api.DeactivateClientRequest{ClientId: cli.ID().Bytes()},
+		)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		assert.False(t, client.IsTokenExpired(err))
+	})
+}
@@ -0,0 +1,226 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+// certificate is a certificate and its key issued for the tests.
+type certificate struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+// issueCertificate issues a certificate of the given template signed by the
+// given parent, and writes it and its key as PEM files in a temporary
+// directory. If the parent is nil, the certificate is self-signed.
+func issueCertificate(t *testing.T, template *x509.Certificate, parent *certificate) *certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	assert.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	assert.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: raw,
+	}), 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PRIVATE KEY",
+		Bytes: keyBytes,
+	}), 0600))
+
+	return &certificate{
+		cert:     cert,
+		key:      key,
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+}
+
+// writeCertificate writes a self-signed client certificate and its key as PEM
+// files in a temporary directory, and returns the paths of the files.
+func writeCertificate(t *testing.T) (string, string) {
+	cert := issueCertificate(t, &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, nil)
+	return cert.certFile, cert.keyFile
+}
+
+// issueCertificates issues a CA, a server certificate of localhost and a
+// client certificate of the given common name signed by the CA.
+func issueCertificates(t *testing.T, clientName string) (*certificate, *certificate, *certificate) {
+	ca := issueCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "yorkie-test-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}, nil)
+	server := issueCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "localhost"},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	cli := issueCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: clientName},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	return ca, server, cli
+}
+
+func TestAuthTLS(t *testing.T) {
+	t.Run("client certificate test", func(t *testing.T) {
+		ca, serverCert, clientCert := issueCertificates(t, "yorkie-service")
+		_, _, unknownCert := issueCertificates(t, "unknown-service")
+
+		userCert := issueCertificate(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "yorkie-user"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, ca)
+
+		var certSubjects []string
+		handler, token := newAuthHandler(t)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			req, err := types.NewAuthWebhookRequest(bytes.NewReader(body))
+			assert.NoError(t, err)
+			certSubjects = append(certSubjects, req.CertificateSubject)
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			handler.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+
+		conf := helper.TestConfig(server.URL)
+		conf.RPC.CertFile = serverCert.certFile
+		conf.RPC.KeyFile = serverCert.keyFile
+		conf.RPC.ClientCAFile = ca.certFile
+		conf.RPC.InternalSubjects = []string{"CN=yorkie-service"}
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+
+		// 01. the client with the certificate of an internal subject is
+		// authenticated without the token.
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{
+			CertFile:       ca.certFile,
+			ClientCertFile: clientCert.certFile,
+			ClientKeyFile:  clientCert.keyFile,
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 02. the client without the certificate needs the token.
+		cliWithoutCert, err := client.Dial(agent.RPCAddr(), client.Option{CertFile: ca.certFile})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithoutCert.Close()) }()
+		err = cliWithoutCert.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+		// 03. the client with the certificate signed by another CA can not
+		// connect to the agent.
+		cliWithUnknownCert, err := client.Dial(agent.RPCAddr(), client.Option{
+			CertFile:       ca.certFile,
+			ClientCertFile: unknownCert.certFile,
+			ClientKeyFile:  unknownCert.keyFile,
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithUnknownCert.Close()) }()
+		assert.Error(t, cliWithUnknownCert.Activate(ctx))
+
+		// 04. the client with the certificate of another subject is still
+		// authorized by the webhook, with the subject of the certificate.
+		assert.Empty(t, certSubjects)
+		cliWithUserCert, err := client.Dial(agent.RPCAddr(), client.Option{
+			CertFile:       ca.certFile,
+			ClientCertFile: userCert.certFile,
+			ClientKeyFile:  userCert.keyFile,
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithUserCert.Close()) }()
+		err = cliWithUserCert.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		assert.Equal(t, []string{"CN=yorkie-user"}, certSubjects)
+
+		cliWithUserCertAndToken, err := client.Dial(agent.RPCAddr(), client.Option{
+			Token:          token,
+			CertFile:       ca.certFile,
+			ClientCertFile: userCert.certFile,
+			ClientKeyFile:  userCert.keyFile,
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithUserCertAndToken.Close()) }()
+		assert.NoError(t, cliWithUserCertAndToken.Activate(ctx))
+		defer func() { assert.NoError(t, cliWithUserCertAndToken.Deactivate(ctx)) }()
+	})
+}
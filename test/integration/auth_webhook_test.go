@@ -20,8 +20,12 @@ package integration
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -36,12 +40,18 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/test/helper"
 	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/webhook"
 )
 
 func newAuthServer(t *testing.T) (*httptest.Server, string) {
+	handler, token := newAuthHandler(t)
+	return httptest.NewServer(handler), token
+}
+
+func newAuthHandler(t *testing.T) (http.Handler, string) {
 	token := xid.New().String()
 
-	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		req, err := types.NewAuthWebhookRequest(r.Body)
 		assert.NoError(t, err)
 
@@ -54,7 +64,7 @@ func newAuthServer(t *testing.T) (*httptest.Server, string) {
 
 		_, err = res.Write(w)
 		assert.NoError(t, err)
-	})), token
+	}), token
 }
 
 func newUnavailableAuthServer(t *testing.T, recoveryCnt uint64) *httptest.Server {
@@ -292,4 +302,197 @@ func TestAuthWebhook(t *testing.T) {
 		}
 		assert.Equal(t, 2, reqCnt)
 	})
+
+	t.Run("read-only permission test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			res.Allowed = true
+			if req.Token == "reader" {
+				res.Permissions = []types.AccessAttribute{{
+					Key:  helper.Collection + "$*",
+					Verb: types.Read,
+				}}
+			}
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		writer, err := client.Dial(agent.RPCAddr(), client.Option{Token: "writer"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, writer.Close()) }()
+		assert.NoError(t, writer.Activate(ctx))
+		defer func() { assert.NoError(t, writer.Deactivate(ctx)) }()
+
+		reader, err := client.Dial(agent.RPCAddr(), client.Option{Token: "reader"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, reader.Close()) }()
+		assert.NoError(t, reader.Activate(ctx))
+		defer func() { assert.NoError(t, reader.Deactivate(ctx)) }()
+
+		d1 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, writer.Attach(ctx, d1))
+		d2 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, reader.Attach(ctx, d2))
+
+		// 01. the changes of the reader are not pushed.
+		assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("reader", "v")
+			return nil
+		}))
+		assert.NoError(t, reader.Sync(ctx))
+		assert.NoError(t, writer.Sync(ctx))
+		assert.Equal(t, `{}`, d1.Marshal())
+
+		// 02. the reader still pulls the changes of the writer.
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("writer", "v")
+			return nil
+		}))
+		assert.NoError(t, writer.Sync(ctx))
+		assert.NoError(t, reader.Sync(ctx))
+		assert.Equal(t, `{"writer":"v"}`, d1.Marshal())
+		assert.Contains(t, d2.Marshal(), `"writer":"v"`)
+	})
+
+	t.Run("expired token test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			if req.Token == "fresh" {
+				res.Allowed = true
+			} else {
+				res.Reason = "token expired"
+				res.Expired = true
+			}
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+
+		// 01. the client with the static token can not recover.
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "stale"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		assert.True(t, client.IsTokenExpired(err))
+
+		// 02. the client with the provider refreshes the token.
+		cliWithProvider, err := client.Dial(agent.RPCAddr(), client.Option{
+			TokenProvider: func(ctx context.Context, expired bool) (string, error) {
+				if expired {
+					return "fresh", nil
+				}
+				return "stale", nil
+			},
+		})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cliWithProvider.Close()) }()
+		assert.NoError(t, cliWithProvider.Activate(ctx))
+		assert.NoError(t, cliWithProvider.Deactivate(ctx))
+	})
+
+	t.Run("signed request test", func(t *testing.T) {
+		secret := []byte("yorkie-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			if webhook.VerifySignature(
+				secret,
+				r.Header.Get(webhook.TimestampHeader),
+				r.Header.Get(webhook.SignatureHeader),
+				body,
+			) {
+				res.Allowed = true
+			} else {
+				res.Reason = "invalid signature"
+			}
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		// 01. the requests of the agent without the secret are rejected.
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: "token"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+		// 02. the requests of the agent with the secret are allowed.
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookSigningSecretFile = secretFile
+		signingAgent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, signingAgent.Start())
+		defer func() { assert.NoError(t, signingAgent.Shutdown(true)) }()
+
+		signingCli, err := client.Dial(signingAgent.RPCAddr(), client.Option{Token: "token"})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, signingCli.Close()) }()
+		assert.NoError(t, signingCli.Activate(ctx))
+		defer func() { assert.NoError(t, signingCli.Deactivate(ctx)) }()
+	})
+
+	t.Run("mutual TLS test", func(t *testing.T) {
+		handler, token := newAuthHandler(t)
+		server := httptest.NewUnstartedServer(handler)
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		server.StartTLS()
+		defer server.Close()
+
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		assert.NoError(t, ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw,
+		}), 0600))
+		certFile, keyFile := writeCertificate(t)
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookMaxRetries = 1
+		conf.Backend.AuthWebhookCAFile = caFile
+		conf.Backend.AuthWebhookCertFile = certFile
+		conf.Backend.AuthWebhookKeyFile = keyFile
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.Option{Token: token})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+	})
 }
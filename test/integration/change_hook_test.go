@@ -0,0 +1,221 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/webhook"
+)
+
+func newChangeHookServer(
+	t *testing.T,
+	recoveryCnt int,
+) (*httptest.Server, chan *types.ChangeHookRequest) {
+	reqCh := make(chan *types.ChangeHookRequest, 10)
+	retries := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := types.NewChangeHookRequest(r.Body)
+		assert.NoError(t, err)
+
+		if retries < recoveryCnt-1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			retries++
+			return
+		}
+
+		reqCh <- req
+	})), reqCh
+}
+
+func TestChangeHook(t *testing.T) {
+	t.Run("change hook test", func(t *testing.T) {
+		server, reqCh := newChangeHookServer(t, 2)
+
+		conf := helper.TestConfig("")
+		conf.Backend.ChangeHookURLs = map[string]string{helper.Collection: server.URL}
+		conf.Backend.ChangeHookIncludeOperations = true
+		conf.Backend.ChangeHookMaxRetries = 3
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetString("k2", "v2")
+			return nil
+		}, "updates k1,k2"))
+		assert.NoError(t, cli.Sync(ctx))
+
+		select {
+		case req := <-reqCh:
+			assert.Equal(t, doc.Key().BSONKey(), req.DocumentKey)
+			assert.Equal(t, cli.ID().String(), req.Actor)
+			assert.Equal(t, req.FromServerSeq, req.ToServerSeq)
+			assert.Len(t, req.Changes, 1)
+			assert.Equal(t, "updates k1,k2", req.Changes[0].Message)
+			assert.Len(t, req.Changes[0].Operations, 2)
+		case <-time.After(time.Second):
+			assert.Fail(t, "change hook is not called")
+		}
+	})
+
+	t.Run("ordered and signed change hook test", func(t *testing.T) {
+		secret := []byte("change-hook-secret")
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+		reqCh := make(chan *types.ChangeHookRequest, 10)
+		retried := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.True(t, webhook.VerifySignature(
+				secret,
+				r.Header.Get(webhook.TimestampHeader),
+				r.Header.Get(webhook.SignatureHeader),
+				body,
+			))
+
+			// the first request is retried, so that the next requests are
+			// enqueued while it waits before retrying.
+			if !retried {
+				retried = true
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			req, err := types.NewChangeHookRequest(bytes.NewReader(body))
+			assert.NoError(t, err)
+			reqCh <- req
+		}))
+		defer server.Close()
+
+		conf := helper.TestConfig("")
+		conf.Backend.ChangeHookURLs = map[string]string{helper.Collection: server.URL}
+		conf.Backend.ChangeHookSigningSecretFile = secretFile
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		pushes := 3
+		for i := 0; i < pushes; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		var lastSeq uint64
+		for i := 0; i < pushes; i++ {
+			select {
+			case req := <-reqCh:
+				if lastSeq != 0 {
+					assert.Equal(t, lastSeq+1, req.FromServerSeq)
+				}
+				lastSeq = req.ToServerSeq
+			case <-time.After(time.Second):
+				assert.Fail(t, "change hook is not called")
+			}
+		}
+	})
+
+	t.Run("hanging change hook test", func(t *testing.T) {
+		called := make(chan struct{}, 1)
+		released := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called <- struct{}{}
+			<-released
+		}))
+		defer server.Close()
+		defer close(released)
+
+		conf := helper.TestConfig("")
+		conf.Backend.ChangeHookURLs = map[string]string{helper.Collection: server.URL}
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		select {
+		case <-called:
+		case <-time.After(time.Second):
+			assert.Fail(t, "change hook is not called")
+		}
+
+		// the hanging change hook does not block the shutdown of the agent.
+		shutdownAt := time.Now()
+		assert.NoError(t, agent.Shutdown(true))
+		assert.Less(t, time.Since(shutdownAt), helper.ChangeHookRequestTimeout)
+	})
+}
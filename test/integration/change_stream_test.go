@@ -0,0 +1,157 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	gomongo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+)
+
+func TestChangeStream(t *testing.T) {
+	clients := createActivatedClients(t, 1)
+	defer cleanupClients(t, clients)
+	cli := clients[0]
+
+	t.Run("watch changes test", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		doc := document.New("change-stream", t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		for _, message := range []string{"first", "second"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", message)
+				return nil
+			}, message))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		rch, err := cli.WatchChanges(ctx, "change-stream", "")
+		assert.NoError(t, err)
+		first := receiveCapturedChange(t, rch)
+		assert.Equal(t, doc.Key().BSONKey(), first.DocumentKey.BSONKey())
+		assert.Equal(t, "first", first.Change.Message())
+		cancel()
+
+		// resume from the cursor of the first change.
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+		rch, err = cli.WatchChanges(ctx, "change-stream", first.Cursor)
+		assert.NoError(t, err)
+		second := receiveCapturedChange(t, rch)
+		assert.Equal(t, "second", second.Change.Message())
+		assert.Equal(t, first.ServerSeq+1, second.ServerSeq)
+	})
+
+	t.Run("migrate legacy changes test", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		collection := "change-stream-migration"
+		doc := document.New(collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		for _, message := range []string{"first", "second"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", message)
+				return nil
+			}, message))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		rch, err := cli.WatchChanges(ctx, collection, "")
+		assert.NoError(t, err)
+		receiveCapturedChange(t, rch)
+		last := receiveCapturedChange(t, rch)
+		assert.Equal(t, "second", last.Change.Message())
+		cancel()
+
+		// 01. the changes stored by the previous versions have no collection
+		// seqs.
+		conf := helper.TestConfig("").Mongo
+		rawCli, err := gomongo.Connect(ctx, options.Client().ApplyURI(conf.ConnectionURI))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, rawCli.Disconnect(ctx)) }()
+		_, err = rawCli.Database(conf.YorkieDatabase).Collection(mongo.ColChanges).UpdateMany(
+			ctx,
+			bson.M{"doc_key": doc.Key().BSONKey()},
+			bson.M{"$unset": bson.M{"collection": "", "collection_seq": "", "stored_at": ""}},
+		)
+		assert.NoError(t, err)
+
+		// 02. the migrated changes are delivered after the cursors already
+		// handed out.
+		mongoCli, err := mongo.Dial(conf)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, mongoCli.Close()) }()
+		docInfos, err := mongoCli.FindDocInfosOfLegacyChanges(ctx)
+		assert.NoError(t, err)
+		for _, docInfo := range docInfos {
+			_, err := mongoCli.MigrateChangeInfos(ctx, docInfo)
+			assert.NoError(t, err)
+		}
+
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+		rch, err = cli.WatchChanges(ctx, collection, last.Cursor)
+		assert.NoError(t, err)
+		assert.Equal(t, "first", receiveCapturedChange(t, rch).Change.Message())
+		assert.Equal(t, "second", receiveCapturedChange(t, rch).Change.Message())
+	})
+
+	t.Run("watch changes without collection test", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rch, err := cli.WatchChanges(ctx, "", "")
+		assert.NoError(t, err)
+
+		select {
+		case resp := <-rch:
+			assert.Equal(t, codes.InvalidArgument, status.Convert(resp.Err).Code())
+		case <-time.After(5 * time.Second):
+			assert.FailNow(t, "watch changes is not rejected")
+		}
+	})
+}
+
+func receiveCapturedChange(t *testing.T, rch <-chan client.CapturedChange) client.CapturedChange {
+	select {
+	case resp := <-rch:
+		assert.NoError(t, resp.Err)
+		return resp
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "change is not captured")
+	}
+	return client.CapturedChange{}
+}
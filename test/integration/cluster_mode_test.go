@@ -20,20 +20,35 @@ package integration
 
 import (
 	"context"
+	"crypto/x509"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"sort"
 	gosync "sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
 )
 
 func keysFromAgents(m map[string]*sync.AgentInfo) []string {
@@ -199,4 +214,190 @@ func TestClusterMode(t *testing.T) {
 			assert.Equal(t, expected, responsePairs)
 		})
 	})
+
+	t.Run("broadcast across agents test", func(t *testing.T) {
+		withTwoClientsAndDocsInClusterMode(t, func(
+			t *testing.T,
+			c1, c2 *client.Client,
+			d1, d2 *document.Document,
+		) {
+			ctx := context.Background()
+
+			watch1Ctx, cancel1 := context.WithCancel(ctx)
+			defer cancel1()
+			wrch, err := c1.Watch(watch1Ctx, d1)
+			assert.NoError(t, err)
+
+			watch2Ctx, cancel2 := context.WithCancel(ctx)
+			defer cancel2()
+			_, err = c2.Watch(watch2Ctx, d2)
+			assert.NoError(t, err)
+
+			assert.NoError(t, c2.Broadcast(ctx, []byte("typing"), d2))
+
+			for {
+				select {
+				case <-time.After(time.Second):
+					assert.Fail(t, "timeout")
+					return
+				case wr := <-wrch:
+					assert.NoError(t, wr.Err)
+					if wr.Type != client.Broadcasted {
+						continue
+					}
+
+					assert.Equal(t, c2.ID().String(), wr.Publisher)
+					assert.Equal(t, []byte("typing"), wr.Payload)
+					assert.Equal(t, d1.Key().BSONKey(), wr.Keys[0].BSONKey())
+					assert.Equal(t, `{}`, d1.Marshal())
+					return
+				}
+			}
+		})
+	})
+
+	t.Run("non-member broadcast test", func(t *testing.T) {
+		secretFile := filepath.Join(t.TempDir(), "secret")
+		assert.NoError(t, ioutil.WriteFile(secretFile, []byte("cluster-secret"), 0600))
+
+		// NOTE: The cluster secret is sent only with TLS.
+		ca, serverCert, _ := issueCertificates(t, "yorkie-agent")
+		conf := helper.TestConfig("")
+		conf.RPC.CertFile = serverCert.certFile
+		conf.RPC.KeyFile = serverCert.keyFile
+		conf.ETCD.ClusterCAFile = ca.certFile
+		conf.ETCD.ClusterSecretFile = secretFile
+		conf.ETCD.ClusterInsecure = false
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+		time.Sleep(100 * time.Millisecond)
+
+		caPool := x509.NewCertPool()
+		caPool.AddCert(ca.cert)
+		conn, err := grpc.Dial(agent.RPCAddr(), grpc.WithTransportCredentials(
+			credentials.NewClientTLSFromCert(caPool, ""),
+		))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		cli := api.NewClusterClient(conn)
+
+		var memberID string
+		for id := range agent.Members() {
+			memberID = id
+		}
+
+		// 01. the callers that are not members are rejected.
+		ctx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			sync.AgentIDMetadataKey, "unknown",
+			sync.ClusterSecretMetadataKey, "cluster-secret",
+		)
+		_, err = cli.BroadcastEvent(ctx, &api.BroadcastEventRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 02. the callers without the cluster secret are rejected.
+		ctx = metadata.AppendToOutgoingContext(
+			context.Background(),
+			sync.AgentIDMetadataKey, memberID,
+			sync.ClusterSecretMetadataKey, "invalid-secret",
+		)
+		_, err = cli.BroadcastEvent(ctx, &api.BroadcastEventRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 03. the members with the cluster secret pass the verification, from
+		// the addresses of their RPC hosts.
+		ctx = metadata.AppendToOutgoingContext(
+			context.Background(),
+			sync.AgentIDMetadataKey, memberID,
+			sync.ClusterSecretMetadataKey, "cluster-secret",
+		)
+		_, err = cli.BroadcastEvent(ctx, &api.BroadcastEventRequest{})
+		assert.NotEqual(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// 04. the cluster secret without the cluster CA is rejected.
+		conf = helper.TestConfig("")
+		conf.ETCD.ClusterSecretFile = secretFile
+		_, err = yorkie.New(conf)
+		assert.ErrorIs(t, err, etcd.ErrInvalidClusterCertificate)
+
+		// 05. the cluster without the secret or the certificates is refused
+		// unless it is configured to be insecure.
+		conf = helper.TestConfig("")
+		conf.ETCD.ClusterInsecure = false
+		_, err = yorkie.New(conf)
+		assert.ErrorIs(t, err, etcd.ErrClusterNotAuthenticated)
+	})
+
+	t.Run("purge auth cache across agents test", func(t *testing.T) {
+		revoked := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			res := types.AuthWebhookResponse{Allowed: !revoked, Subject: "alice"}
+			if revoked {
+				res.Reason = "revoked"
+			}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+
+		ca, serverCert, adminCert := issueCertificates(t, "yorkie-admin")
+		newAgent := func() *yorkie.Yorkie {
+			conf := helper.TestConfig(server.URL)
+			conf.RPC.CertFile = serverCert.certFile
+			conf.RPC.KeyFile = serverCert.keyFile
+			conf.RPC.ClientCAFile = ca.certFile
+			conf.RPC.InternalSubjects = []string{"CN=yorkie-admin"}
+			conf.ETCD.ClusterCAFile = ca.certFile
+			agent, err := yorkie.New(conf)
+			assert.NoError(t, err)
+			assert.NoError(t, agent.Start())
+			return agent
+		}
+		agent1 := newAgent()
+		agent2 := newAgent()
+		defer func() {
+			assert.NoError(t, agent1.Shutdown(true))
+			assert.NoError(t, agent2.Shutdown(true))
+		}()
+		time.Sleep(100 * time.Millisecond)
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent2.RPCAddr(), client.Option{Token: "token", CertFile: ca.certFile})
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 01. the revoked access is still allowed by the cached decisions of
+		// agent2.
+		revoked = true
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. the decisions on other documents are not purged in any member.
+		admin, closeAdmin := dialAdmin(t, agent1.RPCAddr(), ca, adminCert)
+		defer closeAdmin()
+
+		// NOTE: The default agent of the tests does not serve TLS, so it is
+		// reported as a failed member after the others are purged.
+		_, err = admin.PurgeAuthCache(ctx, &api.PurgeAuthCacheRequest{
+			DocumentKey: converter.ToDocumentKey(&key.Key{Collection: helper.Collection, Document: "others"}),
+		})
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 03. the revoked access to agent2 is denied after the decisions of
+		// the subject are purged through agent1.
+		_, err = admin.PurgeAuthCache(ctx, &api.PurgeAuthCacheRequest{Subject: "alice"})
+		assert.Equal(t, codes.Unavailable, status.Convert(err).Code())
+		err = cli.Sync(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
 }
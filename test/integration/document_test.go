@@ -25,6 +25,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
@@ -156,4 +158,107 @@ func TestDocument(t *testing.T) {
 
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
+
+	t.Run("fetch without activation test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddInteger(1, 2, 3)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Sync(ctx))
+
+		cli, err := client.Dial(defaultAgent.RPCAddr())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.Close())
+		}()
+
+		fetched, err := cli.Fetch(ctx, helper.Collection, t.Name())
+		assert.NoError(t, err)
+		assert.False(t, fetched.IsAttached())
+		assert.Equal(t, d1.Marshal(), fetched.Marshal())
+
+		_, err = cli.Fetch(ctx, helper.Collection, "not-exist")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// the snapshot at a server seq behind the last snapshot.
+		serverSeq := d1.Checkpoint().ServerSeq
+		marshaled := d1.Marshal()
+		for i := 0; i < helper.SnapshotThreshold+1; i++ {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k3", i)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		fetched, err = cli.FetchAt(ctx, helper.Collection, t.Name(), serverSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, marshaled, fetched.Marshal())
+		assert.Equal(t, serverSeq, fetched.Checkpoint().ServerSeq)
+
+		_, err = cli.FetchAt(ctx, helper.Collection, t.Name(), d1.Checkpoint().ServerSeq+1)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+	})
+
+	t.Run("apply changes delivered in watch events test", func(t *testing.T) {
+		ctx := context.Background()
+
+		cli, err := client.Dial(defaultAgent.RPCAddr(), client.Option{WatchChanges: true})
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() {
+			assert.NoError(t, cli.Deactivate(ctx))
+			assert.NoError(t, cli.Close())
+		}()
+
+		d1 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, d2))
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		rch, err := cli.Watch(watchCtx, d2)
+		assert.NoError(t, err)
+
+		nextChanged := func() client.WatchResponse {
+			for {
+				resp := <-rch
+				assert.NoError(t, resp.Err)
+				if resp.Type == client.DocumentsChanged {
+					return resp
+				}
+			}
+		}
+		update := func(k, v string) {
+			assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, v)
+				return nil
+			}))
+			assert.NoError(t, c1.Sync(ctx))
+		}
+
+		// 01. apply the delivered changes without PushPull.
+		update("k1", "v1")
+		resp := nextChanged()
+		assert.NotNil(t, resp.ChangePack)
+		assert.NoError(t, cli.ApplyWatchResponse(ctx, resp))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+
+		// 02. fall back to PushPull when some changes are missing.
+		update("k2", "v2")
+		_ = nextChanged()
+		update("k3", "v3")
+		resp = nextChanged()
+		assert.NoError(t, cli.ApplyWatchResponse(ctx, resp))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
 }
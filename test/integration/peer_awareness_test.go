@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
@@ -123,4 +125,190 @@ func TestPeerAwareness(t *testing.T) {
 
 		assert.Equal(t, expected, responsePairs)
 	})
+
+	t.Run("PeerChange and presence test", func(t *testing.T) {
+		ctx := context.Background()
+
+		type cursor struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		}
+
+		d1 := document.New(helper.Collection, t.Name())
+		d2 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		assert.NoError(t, c2.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d2)) }()
+
+		watch1Ctx, cancel1 := context.WithCancel(ctx)
+		defer cancel1()
+		wrch, err := c1.Watch(watch1Ctx, d1)
+		assert.NoError(t, err)
+
+		nextPeerChange := func() *client.PeerChange {
+			for {
+				select {
+				case <-time.After(time.Second):
+					assert.Fail(t, "timeout")
+					return nil
+				case wr := <-wrch:
+					assert.NoError(t, wr.Err)
+					if wr.Type == client.PeersChanged {
+						return wr.PeerChange
+					}
+				}
+			}
+		}
+
+		// 01. PeerJoined is triggered when another client watches the document
+		watch2Ctx, cancel2 := context.WithCancel(ctx)
+		_, err = c2.Watch(watch2Ctx, d2)
+		assert.NoError(t, err)
+		peerChange := nextPeerChange()
+		assert.Equal(t, client.PeerJoined, peerChange.Type)
+		assert.Equal(t, c2.ID().String(), peerChange.PeerID)
+
+		// 02. PeerPresenceChanged is triggered when another client updates its
+		// presence
+		assert.NoError(t, c2.UpdatePresence(ctx, "cursor", cursor{Line: 1, Column: 2}))
+		peerChange = nextPeerChange()
+		assert.Equal(t, client.PeerPresenceChanged, peerChange.Type)
+		assert.Equal(t, c2.ID().String(), peerChange.PeerID)
+
+		presence, err := c1.PeerPresence(d1, c2.ID().String())
+		assert.NoError(t, err)
+		var cur cursor
+		ok, err := presence.Decode("cursor", &cur)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Equal(t, cursor{Line: 1, Column: 2}, cur)
+
+		var name string
+		ok, err = presence.Decode("name", &name)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Equal(t, c2.Metadata()["name"], name)
+
+		// the plain metadata that looks like JSON is kept as a string.
+		assert.NoError(t, c2.UpdateMetadata(ctx, "level", "123"))
+		assert.Equal(t, client.PeerPresenceChanged, nextPeerChange().Type)
+		presence, err = c1.PeerPresence(d1, c2.ID().String())
+		assert.NoError(t, err)
+		var level string
+		ok, err = presence.Decode("level", &level)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Equal(t, "123", level)
+
+		// 03. PeerLeft is triggered when another client closes the watch
+		cancel2()
+		peerChange = nextPeerChange()
+		assert.Equal(t, client.PeerLeft, peerChange.Type)
+		assert.Equal(t, c2.ID().String(), peerChange.PeerID)
+
+		_, err = c1.PeerPresence(d1, c2.ID().String())
+		assert.ErrorIs(t, err, client.ErrPeerNotFound)
+	})
+
+	t.Run("add and remove watch without reopening the stream test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name()+"-1")
+		d2 := document.New(helper.Collection, t.Name()+"-2")
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+		assert.NoError(t, c1.Attach(ctx, d2))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d2)) }()
+
+		d3 := document.New(helper.Collection, t.Name()+"-2")
+		assert.NoError(t, c2.Attach(ctx, d3))
+		defer func() { assert.NoError(t, c2.Detach(ctx, d3)) }()
+
+		watch2Ctx, cancel2 := context.WithCancel(ctx)
+		defer cancel2()
+		wrch, err := c2.Watch(watch2Ctx, d3)
+		assert.NoError(t, err)
+
+		nextPeerChange := func() *client.PeerChange {
+			for {
+				select {
+				case <-time.After(time.Second):
+					assert.Fail(t, "timeout")
+					return nil
+				case wr := <-wrch:
+					assert.NoError(t, wr.Err)
+					if wr.Type == client.PeersChanged {
+						return wr.PeerChange
+					}
+				}
+			}
+		}
+
+		assert.ErrorIs(t, c1.AddWatch(d2), client.ErrWatchNotStarted)
+
+		watch1Ctx, cancel1 := context.WithCancel(ctx)
+		defer cancel1()
+		wrch1, err := c1.Watch(watch1Ctx, d1)
+		assert.NoError(t, err)
+
+		// 01. PeerJoined is triggered when another client adds the document to
+		// its live watch
+		assert.NoError(t, c1.AddWatch(d2))
+		peerChange := nextPeerChange()
+		assert.Equal(t, client.PeerJoined, peerChange.Type)
+		assert.Equal(t, c1.ID().String(), peerChange.PeerID)
+
+		// 02. PeerLeft is triggered when another client removes the document
+		// from its live watch
+		assert.NoError(t, c1.RemoveWatch(d2))
+		peerChange = nextPeerChange()
+		assert.Equal(t, client.PeerLeft, peerChange.Type)
+		assert.Equal(t, c1.ID().String(), peerChange.PeerID)
+
+		// 03. Peers are not notified when the document already watched is
+		// added again
+		assert.NoError(t, c1.AddWatch(d2))
+		assert.Equal(t, client.PeerJoined, nextPeerChange().Type)
+		assert.NoError(t, c1.AddWatch(d2))
+		assert.NoError(t, c1.UpdateMetadata(ctx, "name", t.Name()))
+		assert.Equal(t, client.PeerPresenceChanged, nextPeerChange().Type)
+
+		// 04. The documents can not be added after the watch ends
+		cancel1()
+		for wr := range wrch1 {
+			if wr.Err != nil {
+				break
+			}
+		}
+		assert.Equal(t, client.PeerLeft, nextPeerChange().Type)
+		assert.ErrorIs(t, c1.AddWatch(d2), client.ErrWatchNotStarted)
+		assert.ErrorIs(t, c1.RemoveWatch(d2), client.ErrWatchNotStarted)
+	})
+
+	t.Run("publish without watching test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+
+		// 01. the metadata is not sent to the documents not watched.
+		assert.NoError(t, c1.UpdateMetadata(ctx, "updated", "true"))
+
+		// 02. the message can not be broadcast to the documents not watched.
+		err := c1.Broadcast(ctx, []byte("typing"), d1)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		_, err = c1.Watch(watchCtx, d1)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Broadcast(ctx, []byte("typing"), d1))
+
+		// 03. the message larger than the max payload size is rejected.
+		payload := make([]byte, helper.MaxBroadcastPayloadSize+1)
+		err = c1.Broadcast(ctx, payload, d1)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
 }
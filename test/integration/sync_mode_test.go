@@ -0,0 +1,130 @@
+//go:build integration
+
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestSyncMode(t *testing.T) {
+	clients := createActivatedClients(t, 2)
+	c1 := clients[0]
+	c2 := clients[1]
+	defer cleanupClients(t, clients)
+
+	t.Run("push-only test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.AttachWithOption(ctx, d1, client.AttachOption{SyncMode: client.SyncModePushOnly})
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.Collection, t.Name())
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+		err = d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1"}`, d1.Marshal())
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d2.Marshal())
+
+		// changes pushed in push-only mode should not be applied twice.
+		assert.NoError(t, c1.SetSyncMode(d1, client.SyncModePushPull))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, d2.Marshal(), d1.Marshal())
+	})
+
+	t.Run("pull-only test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.AttachWithOption(ctx, d1, client.AttachOption{SyncMode: client.SyncModePullOnly})
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.Collection, t.Name())
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+		err = d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.True(t, d1.HasLocalChanges())
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d1.Marshal())
+		assert.Equal(t, `{"k2":"v2"}`, d2.Marshal())
+
+		assert.NoError(t, c1.SetSyncMode(d1, client.SyncModePushPull))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+
+	t.Run("paused test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.AttachWithOption(ctx, d1, client.AttachOption{SyncMode: client.SyncModePaused})
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.Collection, t.Name())
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		assert.NoError(t, c1.SetSyncMode(d1, client.SyncModePushPull))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+}
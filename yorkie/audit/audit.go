@@ -0,0 +1,117 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// ErrRecordFailed is returned when the entries of the audit log can not be
+// recorded.
+var ErrRecordFailed = errors.New("fail to record the audit log")
+
+// Record appends the entries of the access of the given method by the given
+// client to the given documents to the audit log. The subject authenticated
+// by the request is recorded with them. It does nothing if the audit log is
+// disabled.
+//
+// The entries are recorded before the access is served, and the access is not
+// served if they can not be recorded, so that no access is missing in the
+// audit log. An entry may therefore remain for an access that fails after it.
+func Record(
+	ctx context.Context,
+	be *backend.Backend,
+	method types.Method,
+	clientID db.ID,
+	docKeys []*key.Key,
+	operations int,
+) error {
+	if !be.Config.AuditLogEnabled || len(docKeys) == 0 {
+		return nil
+	}
+
+	subject, _ := auth.SubjectFromCtx(ctx)
+	now := gotime.Now()
+
+	var logs []*db.AuditLog
+	for _, k := range docKeys {
+		logs = append(logs, &db.AuditLog{
+			Subject:    subject,
+			ClientID:   clientID,
+			Method:     string(method),
+			DocKey:     k.BSONKey(),
+			Operations: operations,
+			CreatedAt:  now,
+		})
+	}
+
+	if err := be.DB.CreateAuditLogs(ctx, logs); err != nil {
+		be.Metrics.AddAuditLogFailures(len(logs))
+		return fmt.Errorf("%s of %s: %s: %w", method, clientID, err.Error(), ErrRecordFailed)
+	}
+
+	return nil
+}
+
+// RecordDenied appends the entries of the access of the given method by the
+// given client denied on the given attributes to the audit log. It does
+// nothing if the audit log is disabled.
+//
+// The access is rejected whether or not the entries are recorded, so a
+// failure is reported in the agent log and counted in the
+// yorkie_audit_log_failures_total metric, and the client gets the denial.
+func RecordDenied(
+	ctx context.Context,
+	be *backend.Backend,
+	method types.Method,
+	clientID db.ID,
+	attrs []types.AccessAttribute,
+) {
+	if !be.Config.AuditLogEnabled || len(attrs) == 0 {
+		return
+	}
+
+	subject, _ := auth.SubjectFromCtx(ctx)
+	now := gotime.Now()
+
+	var logs []*db.AuditLog
+	for _, attr := range attrs {
+		logs = append(logs, &db.AuditLog{
+			Subject:   subject,
+			ClientID:  clientID,
+			Method:    string(method),
+			DocKey:    attr.Key,
+			Denied:    true,
+			CreatedAt: now,
+		})
+	}
+
+	if err := be.DB.CreateAuditLogs(ctx, logs); err != nil {
+		log.Logger.Errorf("fail to record denied %s of %s: %s", method, clientID, err.Error())
+		be.Metrics.AddAuditLogFailures(len(logs))
+	}
+}
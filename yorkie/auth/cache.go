@@ -0,0 +1,148 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+)
+
+// cacheKey is the key of a decision of the authorization webhook. The
+// decisions are cached per token, certificate subject and document key, so
+// that the accesses to the same document hit the cache regardless of the
+// other documents requested together. The methods without documents use the
+// empty document key.
+type cacheKey struct {
+	token       string
+	certSubject string
+	method      types.Method
+	docKey      string
+	verb        types.VerbType
+}
+
+// cacheKeysOf returns the keys of the decisions on the attributes of the given
+// access in order. It returns a key with the empty document key for the access
+// without attributes.
+func cacheKeysOf(token string, certSubject string, info *types.AccessInfo) []cacheKey {
+	if len(info.Attributes) == 0 {
+		return []cacheKey{{token: token, certSubject: certSubject, method: info.Method}}
+	}
+
+	keys := make([]cacheKey, len(info.Attributes))
+	for i, attr := range info.Attributes {
+		keys[i] = cacheKey{
+			token:       token,
+			certSubject: certSubject,
+			method:      info.Method,
+			docKey:      attr.Key,
+			verb:        attr.Verb,
+		}
+	}
+	return keys
+}
+
+// String returns the string of this key to be used in the cache.
+func (k cacheKey) String() string {
+	return strings.Join([]string{
+		strconv.Quote(k.token),
+		strconv.Quote(k.certSubject),
+		string(k.method),
+		strconv.Quote(k.docKey),
+		string(k.verb),
+	}, ":")
+}
+
+// cacheEntry is a decision of the authorization webhook in the cache.
+type cacheEntry struct {
+	key  cacheKey
+	resp *types.AuthWebhookResponse
+}
+
+// getCachedResponse returns the cached response of the given key.
+func getCachedResponse(be *backend.Backend, key cacheKey) (*types.AuthWebhookResponse, bool) {
+	entry, ok := be.AuthWebhookCache.Get(key.String())
+	if !ok {
+		return nil, false
+	}
+
+	return entry.(*cacheEntry).resp, true
+}
+
+// cacheResponse caches the given response of the webhook with the given keys.
+// The TTL given by the webhook precedes the TTL of the configuration.
+func cacheResponse(be *backend.Backend, keys []cacheKey, resp *types.AuthWebhookResponse) error {
+	ttl, ok, err := resp.ParseCacheTTL()
+	if err != nil {
+		return err
+	}
+	if !ok && resp.Allowed {
+		ttl = be.Config.ParseAuthWebhookCacheAuthTTL()
+	} else if !ok {
+		ttl = be.Config.ParseAuthWebhookCacheUnauthTTL()
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	for _, key := range keys {
+		be.AuthWebhookCache.Add(key.String(), &cacheEntry{key: key, resp: resp}, ttl)
+	}
+
+	return nil
+}
+
+// CacheFilter selects the cached decisions of the authorization webhook. The
+// empty fields match any decisions, so the empty filter selects all of them.
+type CacheFilter struct {
+	// Token is the token of the decisions.
+	Token string
+
+	// Subject is the subject returned by the webhook with the decisions.
+	Subject string
+
+	// DocKey is the key of the document of the decisions, such as
+	// "collection$document".
+	DocKey string
+}
+
+// matches returns whether this filter selects the given entry.
+func (f *CacheFilter) matches(entry *cacheEntry) bool {
+	if f.Token != "" && f.Token != entry.key.token {
+		return false
+	}
+	if f.Subject != "" && f.Subject != entry.resp.Subject {
+		return false
+	}
+	if f.DocKey != "" && f.DocKey != entry.key.docKey {
+		return false
+	}
+
+	return true
+}
+
+// PurgeCache removes the cached decisions of the authorization webhook selected
+// by the given filter, and returns the number of them. It is used to apply the
+// revoked accesses before the cached decisions expire.
+func PurgeCache(be *backend.Backend, filter *CacheFilter) int {
+	return be.AuthWebhookCache.RemoveAll(func(key string, value interface{}) bool {
+		entry, ok := value.(*cacheEntry)
+		return ok && filter.matches(entry)
+	})
+}
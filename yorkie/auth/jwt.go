@@ -0,0 +1,346 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+)
+
+var (
+	// ErrInvalidToken is returned when the given token is not a valid JWT
+	// signed with one of the keys of the verifier.
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrInvalidJWTKey is returned when the given key to verify JWTs is not
+	// valid.
+	ErrInvalidJWTKey = errors.New("invalid JWT key")
+)
+
+// Claims is the claims of the JWTs verified by JWTVerifier. Permissions grant
+// the verbs on the documents whose keys match their glob patterns, such as
+// "todos$*". See grantedVerb for the details.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	Permissions []types.AccessAttribute `json:"permissions"`
+}
+
+// verificationKey is a key to verify the signatures of JWTs.
+type verificationKey struct {
+	// id is the key ID matched with the "kid" header. Keys without the ID are
+	// matched with any tokens.
+	id string
+
+	// key is one of []byte for HMAC, *rsa.PublicKey and *ecdsa.PublicKey.
+	key interface{}
+}
+
+// accepts returns whether this key can verify the given signing method.
+func (k *verificationKey) accepts(method jwt.SigningMethod) bool {
+	switch k.key.(type) {
+	case []byte:
+		_, ok := method.(*jwt.SigningMethodHMAC)
+		return ok
+	case *rsa.PublicKey:
+		switch method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			return true
+		}
+	case *ecdsa.PublicKey:
+		_, ok := method.(*jwt.SigningMethodECDSA)
+		return ok
+	}
+
+	return false
+}
+
+// JWTVerifier verifies JWTs signed with HMAC, RSA or ECDSA keys locally, as an
+// alternative to the authorization webhook.
+type JWTVerifier struct {
+	keys     []*verificationKey
+	parser   *jwt.Parser
+	issuer   string
+	audience string
+}
+
+// NewJWTVerifier creates a new instance of JWTVerifier with the keys in the
+// files of the given config. It returns nil if no key file is configured.
+func NewJWTVerifier(conf *backend.Config) (*JWTVerifier, error) {
+	if !conf.AuthJWTEnabled() {
+		return nil, nil
+	}
+
+	var keys []*verificationKey
+	if conf.AuthJWTHMACSecretFile != "" {
+		secret, err := readKeyFile(conf.AuthJWTHMACSecretFile)
+		if err != nil {
+			return nil, err
+		}
+		secret = bytes.TrimSpace(secret)
+		if len(secret) == 0 {
+			return nil, fmt.Errorf("%s: empty secret: %w", conf.AuthJWTHMACSecretFile, ErrInvalidJWTKey)
+		}
+		keys = append(keys, &verificationKey{key: secret})
+	}
+
+	if conf.AuthJWTPublicKeyFile != "" {
+		key, err := readPublicKeyFile(conf.AuthJWTPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if conf.AuthJWTJWKSFile != "" {
+		jwksKeys, err := readJWKSFile(conf.AuthJWTJWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, jwksKeys...)
+	}
+
+	return &JWTVerifier{
+		keys: keys,
+		parser: jwt.NewParser(jwt.WithValidMethods([]string{
+			"HS256", "HS384", "HS512",
+			"RS256", "RS384", "RS512",
+			"PS256", "PS384", "PS512",
+			"ES256", "ES384", "ES512",
+		})),
+		issuer:   conf.AuthJWTIssuer,
+		audience: conf.AuthJWTAudience,
+	}, nil
+}
+
+// Verify verifies the signature and the registered claims of the given token,
+// and returns its claims. The token is verified with each key that matches its
+// "kid" header and signing method until one of them verifies the signature,
+// since the keys without IDs can not be told apart. The token must have the
+// "exp" claim. It returns ErrTokenExpired if the token has expired.
+func (v *JWTVerifier) Verify(token string) (*Claims, error) {
+	err := fmt.Errorf("no key for the token: %w", ErrInvalidToken)
+	for _, k := range v.keys {
+		claims := &Claims{}
+		_, parseErr := v.parser.ParseWithClaims(token, claims, k.keyFunc)
+		if parseErr == nil {
+			return v.verifyClaims(claims)
+		}
+
+		var validationErr *jwt.ValidationError
+		if !errors.As(parseErr, &validationErr) {
+			return nil, fmt.Errorf("%s: %w", parseErr.Error(), ErrInvalidToken)
+		}
+
+		// NOTE: The expiration is validated before the signature, so the
+		//       token that the key can not verify is tried with the next key
+		//       even if it has expired.
+		if validationErr.Errors&(jwt.ValidationErrorUnverifiable|jwt.ValidationErrorSignatureInvalid) != 0 {
+			err = fmt.Errorf("%s: %w", parseErr.Error(), ErrInvalidToken)
+			continue
+		}
+		if validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, fmt.Errorf("%s: %w", parseErr.Error(), ErrTokenExpired)
+		}
+		return nil, fmt.Errorf("%s: %w", parseErr.Error(), ErrInvalidToken)
+	}
+
+	return nil, err
+}
+
+// verifyClaims verifies the registered claims of the token whose signature is
+// verified.
+func (v *JWTVerifier) verifyClaims(claims *Claims) (*Claims, error) {
+	if claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("missing exp claim: %w", ErrInvalidToken)
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer %q: %w", claims.Issuer, ErrInvalidToken)
+	}
+	if v.audience != "" && !claims.VerifyAudience(v.audience, true) {
+		return nil, fmt.Errorf("unexpected audience %v: %w", claims.Audience, ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// keyFunc returns this key if it can verify the given token. The key is
+// matched by the "kid" header and the signing method of the token, so that a
+// key is never used with a signing method of another type.
+func (k *verificationKey) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid != "" && k.id != "" && k.id != kid {
+		return nil, fmt.Errorf("key %q for kid %q", k.id, kid)
+	}
+	if !k.accepts(token.Method) {
+		return nil, fmt.Errorf("key for other than %s", token.Method.Alg())
+	}
+
+	return k.key, nil
+}
+
+// readPublicKeyFile reads the RSA or ECDSA public key in the given PEM file.
+func readPublicKeyFile(filePath string) (*verificationKey, error) {
+	file, err := readKeyFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsaKey, err := jwt.ParseRSAPublicKeyFromPEM(file); err == nil {
+		return &verificationKey{key: rsaKey}, nil
+	}
+	if ecdsaKey, err := jwt.ParseECPublicKeyFromPEM(file); err == nil {
+		return &verificationKey{key: ecdsaKey}, nil
+	}
+
+	return nil, fmt.Errorf("%s: not an RSA or ECDSA public key: %w", filePath, ErrInvalidJWTKey)
+}
+
+// jwk is a JSON Web Key of RFC 7517. Only the members for verification keys
+// are declared.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// N and E are the modulus and the exponent of an RSA key.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// Crv, X and Y are the curve and the coordinates of an EC key.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+
+	// K is the value of a symmetric key.
+	K string `json:"k"`
+}
+
+// readJWKSFile reads the verification keys in the given JWKS file. The keys
+// for other uses than signatures are skipped.
+func readJWKSFile(filePath string) ([]*verificationKey, error) {
+	file, err := readKeyFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := struct {
+		Keys []*jwk `json:"keys"`
+	}{}
+	if err := json.Unmarshal(file, &jwks); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", filePath, err.Error(), ErrInvalidJWTKey)
+	}
+
+	var keys []*verificationKey
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.toVerificationKey()
+		if err != nil {
+			return nil, fmt.Errorf("%s: kid %q: %w", filePath, k.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (k *jwk) toVerificationKey() (*verificationKey, error) {
+	switch k.Kty {
+	case "oct":
+		secret, err := decodeBase64URL(k.K)
+		if err != nil {
+			return nil, err
+		}
+		return &verificationKey{id: k.Kid, key: secret}, nil
+	case "RSA":
+		n, err := decodeBase64URL(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URL(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &verificationKey{id: k.Kid, key: &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q: %w", k.Crv, ErrInvalidJWTKey)
+		}
+
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &verificationKey{id: k.Kid, key: &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q: %w", k.Kty, ErrInvalidJWTKey)
+	}
+}
+
+func decodeBase64URL(value string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(decoded) == 0 {
+		return nil, fmt.Errorf("invalid base64url value %q: %w", value, ErrInvalidJWTKey)
+	}
+
+	return decoded, nil
+}
+
+func readKeyFile(filePath string) ([]byte, error) {
+	file, err := ioutil.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidJWTKey)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"path"
+
+	"github.com/yorkie-team/yorkie/pkg/types"
+)
+
+// requestedVerbs returns the verbs of the given attributes in order.
+func requestedVerbs(attrs []types.AccessAttribute) []types.VerbType {
+	verbs := make([]types.VerbType, len(attrs))
+	for i, attr := range attrs {
+		verbs[i] = attr.Verb
+	}
+	return verbs
+}
+
+// grantedVerbs returns the verbs granted by the given permissions on the given
+// attributes in order.
+func grantedVerbs(permissions []types.AccessAttribute, attrs []types.AccessAttribute) []types.VerbType {
+	verbs := make([]types.VerbType, len(attrs))
+	for i, attr := range attrs {
+		verbs[i] = grantedVerb(permissions, attr.Key)
+	}
+	return verbs
+}
+
+// grantedVerb returns the strongest verb granted by the given permissions on
+// the document of the given key. The keys of the permissions are glob patterns
+// of path.Match. It returns an empty verb if nothing is granted.
+func grantedVerb(permissions []types.AccessAttribute, docKey string) types.VerbType {
+	var verb types.VerbType
+	for _, permission := range permissions {
+		matched, err := path.Match(permission.Key, docKey)
+		if err != nil || !matched {
+			continue
+		}
+
+		if permission.Verb == types.ReadWrite {
+			return types.ReadWrite
+		}
+		if permission.Verb == types.Read {
+			verb = types.Read
+		}
+	}
+
+	return verb
+}
+
+// covers returns whether the granted verb covers the requested verb.
+func covers(granted types.VerbType, requested types.VerbType) bool {
+	switch granted {
+	case types.ReadWrite:
+		return true
+	case types.Read:
+		return requested == types.Read
+	default:
+		return false
+	}
+}
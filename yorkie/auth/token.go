@@ -1,10 +1,36 @@
 package auth
 
-import "context"
+import (
+	"context"
+	"errors"
+	gosync "sync"
+)
 
 type key int
 
-const tokenKey key = 0
+const (
+	tokenKey key = iota
+	claimsKey
+	principalKey
+	certificateSubjectKey
+	internalSubjectKey
+)
+
+var (
+	// ErrCertificateRequired is returned when the request that is allowed only
+	// for the internal services, such as the admin requests, is not
+	// authenticated by a verified client certificate of an internal service.
+	ErrCertificateRequired = errors.New("verified client certificate of an internal service required")
+)
+
+// principal is the subject authenticated while verifying the access of a
+// request. The webhook tells the subject with its response, so the principal
+// is filled after the context of the request is created.
+type principal struct {
+	mu            gosync.Mutex
+	authenticated bool
+	subject       string
+}
 
 // TokenFromCtx returns the tokenKey from the given context.
 func TokenFromCtx(ctx context.Context) string {
@@ -15,3 +41,76 @@ func TokenFromCtx(ctx context.Context) string {
 func CtxWithToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, tokenKey, token)
 }
+
+// ClaimsFromCtx returns the claims of the verified JWT from the given context.
+// It returns nil if the token is not verified as a JWT.
+func ClaimsFromCtx(ctx context.Context) *Claims {
+	claims, ok := ctx.Value(claimsKey).(*Claims)
+	if !ok {
+		return nil
+	}
+	return claims
+}
+
+// CtxWithClaims creates a new context with the given claims.
+func CtxWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
+}
+
+// CertificateSubjectFromCtx returns the subject of the client certificate
+// verified by the RPC server from the given context.
+func CertificateSubjectFromCtx(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(certificateSubjectKey).(string)
+	return subject, ok
+}
+
+// CtxWithCertificateSubject creates a new context with the subject of the
+// verified client certificate.
+func CtxWithCertificateSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, certificateSubjectKey, subject)
+}
+
+// InternalSubjectFromCtx returns the subject of the client certificate of an
+// internal service verified by the RPC server from the given context.
+func InternalSubjectFromCtx(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(internalSubjectKey).(string)
+	return subject, ok
+}
+
+// CtxWithInternalSubject creates a new context with the subject of the
+// verified client certificate of an internal service.
+func CtxWithInternalSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, internalSubjectKey, subject)
+}
+
+// CtxWithPrincipal creates a new context that holds the subject authenticated
+// while verifying the access of the request.
+func CtxWithPrincipal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, principalKey, &principal{})
+}
+
+// SubjectFromCtx returns the subject authenticated by the token of the given
+// context. It returns false if no access of the request has been verified.
+func SubjectFromCtx(ctx context.Context) (string, bool) {
+	p, ok := ctx.Value(principalKey).(*principal)
+	if !ok {
+		return "", false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.subject, p.authenticated
+}
+
+// setSubject sets the subject authenticated by the token of the given context.
+func setSubject(ctx context.Context, subject string) {
+	p, ok := ctx.Value(principalKey).(*principal)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.authenticated = true
+	p.subject = subject
+}
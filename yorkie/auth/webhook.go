@@ -6,26 +6,33 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"net/http"
-	"syscall"
 	"time"
 
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/webhook"
 )
 
 var (
 	// ErrNotAllowed is returned when the given user is not allowed for the access.
 	ErrNotAllowed = errors.New("method is not allowed for this user")
 
+	// ErrPermissionDenied is returned when the changes are pushed to the
+	// document on which only Read is granted.
+	ErrPermissionDenied = errors.New("write permission is not granted")
+
+	// ErrTokenExpired is returned when the given token has expired. The
+	// clients should refresh their tokens instead of retrying with them.
+	ErrTokenExpired = errors.New("token expired")
+
 	// ErrUnexpectedStatusCode is returned when the response code is not 200 from the webhook.
-	ErrUnexpectedStatusCode = errors.New("unexpected status code from webhook")
+	ErrUnexpectedStatusCode = webhook.ErrUnexpectedStatusCode
 
 	// ErrWebhookTimeout is returned when the webhook does not respond in time.
-	ErrWebhookTimeout = errors.New("webhook timeout")
+	ErrWebhookTimeout = webhook.ErrWebhookTimeout
 )
 
 // AccessAttributes returns an array of AccessAttribute from the given pack.
@@ -43,122 +50,256 @@ func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
 	}}
 }
 
-// VerifyAccess verifies the given access.
+// IsDenied returns whether the given error is returned because the access is
+// denied, rather than the authorization failed.
+func IsDenied(err error) bool {
+	return errors.Is(err, ErrNotAllowed) ||
+		errors.Is(err, ErrPermissionDenied) ||
+		errors.Is(err, ErrInvalidToken)
+}
+
+// VerifyAccess verifies the given access. All the verbs of the given attributes
+// should be granted.
 func VerifyAccess(ctx context.Context, be *backend.Backend, info *types.AccessInfo) error {
-	if !be.Config.RequireAuth(info.Method) {
-		return nil
+	verbs, err := authorize(ctx, be, info)
+	if err != nil {
+		return err
 	}
 
-	reqBody, err := json.Marshal(types.AuthWebhookRequest{
-		Token:      TokenFromCtx(ctx),
-		Method:     info.Method,
-		Attributes: info.Attributes,
+	for i, attr := range info.Attributes {
+		if !covers(verbs[i], attr.Verb) {
+			return fmt.Errorf("%s is not granted on %s: %w", attr.Verb, attr.Key, ErrNotAllowed)
+		}
+	}
+
+	return nil
+}
+
+// VerifyPackAccess verifies the access of the given method to the document of
+// the given pack. Unlike VerifyAccess, the access without changes is allowed
+// with Read, and the changes pushed with Read are rejected with
+// ErrPermissionDenied, so that the client can tell them from the accesses
+// denied by the authorization.
+func VerifyPackAccess(
+	ctx context.Context,
+	be *backend.Backend,
+	method types.Method,
+	pack *change.Pack,
+) error {
+	attrs := AccessAttributes(pack)
+	verbs, err := authorize(ctx, be, &types.AccessInfo{
+		Method:     method,
+		Attributes: attrs,
 	})
 	if err != nil {
 		return err
 	}
 
-	cacheKey := string(reqBody)
-	if entry, ok := be.AuthWebhookCache.Get(cacheKey); ok {
-		resp := entry.(*types.AuthWebhookResponse)
-		if !resp.Allowed {
-			return fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
-		}
-		return nil
+	if !covers(verbs[0], types.Read) {
+		return fmt.Errorf("%s is not granted on %s: %w", types.Read, attrs[0].Key, ErrNotAllowed)
 	}
 
-	var authResp *types.AuthWebhookResponse
-	if err := withExponentialBackoff(ctx, be.Config, func() (int, error) {
-		resp, err := http.Post(
-			be.Config.AuthWebhookURL,
-			"application/json",
-			bytes.NewBuffer(reqBody),
+	if verbs[0] == types.Read && pack.HasChanges() {
+		return fmt.Errorf(
+			"%d changes of %s: %w",
+			pack.ChangesLen(),
+			attrs[0].Key,
+			ErrPermissionDenied,
 		)
-		if err != nil {
-			return 0, err
+	}
+
+	return nil
+}
+
+// authorize returns the verbs granted on the given attributes in order. An
+// empty verb means that nothing is granted on the attribute. The requests with
+// the verified client certificates of the internal services are granted the
+// requested verbs without tokens. The subjects of the other verified
+// certificates are the principals of the requests, but the accesses are still
+// authorized by the webhook or the JWT.
+func authorize(ctx context.Context, be *backend.Backend, info *types.AccessInfo) ([]types.VerbType, error) {
+	if subject, ok := InternalSubjectFromCtx(ctx); ok {
+		setSubject(ctx, subject)
+		return requestedVerbs(info.Attributes), nil
+	}
+
+	if !be.Config.RequireAuth(info.Method) {
+		if subject, ok := CertificateSubjectFromCtx(ctx); ok {
+			setSubject(ctx, subject)
 		}
+		return requestedVerbs(info.Attributes), nil
+	}
+
+	if be.Config.AuthJWTEnabled() {
+		claims := ClaimsFromCtx(ctx)
+		if claims == nil {
+			return nil, fmt.Errorf("token is not verified: %w", ErrNotAllowed)
+		}
+		setSubject(ctx, principalOf(ctx, claims.Subject))
+		return grantedVerbs(claims.Permissions, info.Attributes), nil
+	}
+
+	return authorizeByWebhook(ctx, be, info)
+}
+
+// authorizeByWebhook returns the verbs granted by the authorization webhook on
+// the given attributes in order. The decisions are cached per token and
+// document key, and only the attributes missing in the cache are sent to the
+// webhook.
+func authorizeByWebhook(
+	ctx context.Context,
+	be *backend.Backend,
+	info *types.AccessInfo,
+) ([]types.VerbType, error) {
+	token := TokenFromCtx(ctx)
+	certSubject, _ := CertificateSubjectFromCtx(ctx)
+	keys := cacheKeysOf(token, certSubject, info)
+	resps := make([]*types.AuthWebhookResponse, len(keys))
 
-		defer func() {
-			if err := resp.Body.Close(); err != nil {
-				log.Logger.Error(err)
+	var missedKeys []cacheKey
+	missedInfo := &types.AccessInfo{Method: info.Method}
+	for i, key := range keys {
+		resp, ok := getCachedResponse(be, key)
+		if !ok {
+			missedKeys = append(missedKeys, key)
+			if len(info.Attributes) > 0 {
+				missedInfo.Attributes = append(missedInfo.Attributes, info.Attributes[i])
 			}
-		}()
+			continue
+		}
 
-		if http.StatusOK != resp.StatusCode {
-			return resp.StatusCode, ErrUnexpectedStatusCode
+		if !resp.Allowed {
+			return nil, notAllowedError(resp)
 		}
+		resps[i] = resp
+	}
 
-		authResp, err = types.NewAuthWebhookResponse(resp.Body)
+	if len(missedKeys) > 0 {
+		resp, err := requestAuthWebhook(ctx, be, token, certSubject, missedInfo)
 		if err != nil {
-			return resp.StatusCode, err
+			return nil, err
 		}
 
-		if !authResp.Allowed {
-			return resp.StatusCode, fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
+		if !resp.Allowed {
+			// NOTE: A denial of several documents does not tell which of
+			//       them are denied, so it is cached only for one document.
+			if len(missedKeys) == 1 {
+				if err := cacheResponse(be, missedKeys, resp); err != nil {
+					return nil, err
+				}
+			}
+			return nil, notAllowedError(resp)
 		}
 
-		return resp.StatusCode, nil
-	}); err != nil {
-		if errors.Is(err, ErrNotAllowed) {
-			be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheUnauthTTL())
+		if err := cacheResponse(be, missedKeys, resp); err != nil {
+			return nil, err
+		}
+		for i := range resps {
+			if resps[i] == nil {
+				resps[i] = resp
+			}
 		}
-
-		return err
 	}
+	setSubject(ctx, principalOf(ctx, resps[0].Subject))
 
-	be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheAuthTTL())
+	verbs := make([]types.VerbType, len(info.Attributes))
+	for i, attr := range info.Attributes {
+		// NOTE: The responses without permissions grant the requested verbs
+		//       as they are, for the webhooks written before the permissions.
+		if len(resps[i].Permissions) == 0 {
+			verbs[i] = attr.Verb
+		} else {
+			verbs[i] = grantedVerb(resps[i].Permissions, attr.Key)
+		}
+	}
+	return verbs, nil
+}
 
-	return nil
+// principalOf returns the subject of the verified client certificate of the
+// given context if any, otherwise the given subject authenticated by the token.
+func principalOf(ctx context.Context, subject string) string {
+	if certSubject, ok := CertificateSubjectFromCtx(ctx); ok {
+		return certSubject
+	}
+	return subject
 }
 
-func withExponentialBackoff(ctx context.Context, cfg *backend.Config, webhookFn func() (int, error)) error {
-	var retries uint64
-	var statusCode int
-	for retries <= cfg.AuthWebhookMaxRetries {
-		statusCode, err := webhookFn()
-		if !shouldRetry(statusCode, err) {
-			if err == ErrUnexpectedStatusCode {
-				return fmt.Errorf("unexpected status code from webhook: %d", statusCode)
-			}
+// requestAuthWebhook sends the given access to the authorization webhook and
+// returns the response. The response that does not allow the access is also
+// returned without an error.
+func requestAuthWebhook(
+	ctx context.Context,
+	be *backend.Backend,
+	token string,
+	certSubject string,
+	info *types.AccessInfo,
+) (*types.AuthWebhookResponse, error) {
+	reqBody, err := json.Marshal(types.AuthWebhookRequest{
+		Token:              token,
+		Method:             info.Method,
+		Attributes:         info.Attributes,
+		CertificateSubject: certSubject,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			return err
-		}
+	var authResp *types.AuthWebhookResponse
+	if err := webhook.WithExponentialBackoff(
+		ctx,
+		be.Config.AuthWebhookMaxRetries,
+		be.Config.ParseAuthWebhookMaxWaitInterval(),
+		func() (int, error) {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				http.MethodPost,
+				be.Config.AuthWebhookURL,
+				bytes.NewBuffer(reqBody),
+			)
+			if err != nil {
+				return 0, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if be.AuthWebhookSecret != nil {
+				webhook.SignRequest(req, be.AuthWebhookSecret, reqBody, time.Now())
+			}
 
-		waitBeforeRetry := waitInterval(retries, cfg.ParseAuthWebhookMaxWaitInterval())
+			resp, err := be.AuthWebhookClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
 
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(waitBeforeRetry):
-		}
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
 
-		retries++
-	}
+			if http.StatusOK != resp.StatusCode {
+				return resp.StatusCode, ErrUnexpectedStatusCode
+			}
 
-	return fmt.Errorf("unexpected status code from webhook %d: %w", statusCode, ErrWebhookTimeout)
-}
+			authResp, err = types.NewAuthWebhookResponse(resp.Body)
+			if err != nil {
+				return resp.StatusCode, err
+			}
 
-// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
-func waitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
-	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
-	if maxWaitInterval < interval {
-		return maxWaitInterval
+			return resp.StatusCode, nil
+		},
+	); err != nil {
+		return nil, err
 	}
 
-	return interval
+	return authResp, nil
 }
 
-// shouldRetry returns true if the given error should be retried.
-// Refer to https://github.com/kubernetes/kubernetes/search?q=DefaultShouldRetry
-func shouldRetry(statusCode int, err error) bool {
-	// If the connection is reset, we should retry.
-	var errno syscall.Errno
-	if errors.As(err, &errno) {
-		return errno == syscall.ECONNRESET
+// notAllowedError returns the error of the given response that does not allow
+// the access. The responses with the expired tokens are distinguished so that
+// the clients can refresh their tokens.
+func notAllowedError(resp *types.AuthWebhookResponse) error {
+	if resp.Expired {
+		return fmt.Errorf("%s: %w", resp.Reason, ErrTokenExpired)
 	}
 
-	return statusCode == http.StatusInternalServerError ||
-		statusCode == http.StatusServiceUnavailable ||
-		statusCode == http.StatusGatewayTimeout ||
-		statusCode == http.StatusTooManyRequests
+	return fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
 }
@@ -17,7 +17,13 @@
 package backend
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"path/filepath"
 	gosync "sync"
 	"time"
 
@@ -31,9 +37,12 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/memory"
 	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/yorkie/schema"
+	"github.com/yorkie-team/yorkie/yorkie/webhook"
 )
 
-const authWebhookCacheSize = 5000
+// DocCacheTTL is the TTL of the documents in the document cache.
+const DocCacheTTL = 10 * time.Minute
 
 // Backend manages Yorkie's backend such as Database and Coordinator. And it
 // has the server status such as the information of this Agent.
@@ -45,10 +54,48 @@ type Backend struct {
 	Coordinator      sync.Coordinator
 	Metrics          *prometheus.Metrics
 	AuthWebhookCache *cache.LRUExpireCache
+	Schemas          schema.Registry
+
+	// AuthWebhookClient is the HTTP client to call the authorization webhook.
+	AuthWebhookClient *http.Client
+
+	// ChangeHookClient is the HTTP client to call the change hooks.
+	ChangeHookClient *http.Client
+
+	// ChangeHookQueues keeps the queues of the change hook requests by
+	// collection, so that the requests of a collection are sent one by one in
+	// the order of the changes.
+	ChangeHookQueues map[string]*webhook.Queue
+
+	// AuthWebhookSecret is the secret to sign the requests to the
+	// authorization webhook. It is nil if the requests are not signed.
+	AuthWebhookSecret []byte
+
+	// ChangeHookSecret is the secret to sign the requests to the change hooks.
+	// It is nil if the requests are not signed.
+	ChangeHookSecret []byte
+
+	// ClusterSecret is the secret shared by the agents of the cluster to
+	// authenticate the cluster requests. It is nil if it is not configured.
+	ClusterSecret []byte
+
+	// ClusterInsecure is whether to accept the cluster requests authenticated
+	// only by the addresses of the members.
+	ClusterInsecure bool
+
+	// DocCache keeps the documents materialized from the database by doc ID,
+	// so that snapshots are created by applying only the changes after them.
+	// It is nil if the document cache is disabled.
+	DocCache *cache.LRUExpireCache
 
 	// closing is closed by backend close.
 	closing chan struct{}
 
+	// ctx is canceled by backend close, so that the goroutines attached to the
+	// backend stop waiting for external services.
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	// wgMu blocks concurrent WaitGroup mutation while backend closing
 	wgMu gosync.RWMutex
 
@@ -77,12 +124,27 @@ func New(
 		UpdatedAt: time.Now(),
 	}
 
+	schemas := schema.Registry{}
+	if conf.SchemaFile != "" {
+		schemas, err = schema.NewRegistryFromFile(conf.SchemaFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authWebhookClient, authWebhookSecret, err := newAuthWebhookClient(conf)
+	if err != nil {
+		return nil, err
+	}
+
 	mongoClient, err := mongo.Dial(mongoConf)
 	if err != nil {
 		return nil, err
 	}
 
 	var coordinator sync.Coordinator
+	var clusterSecret []byte
+	var clusterInsecure bool
 	if etcdConf != nil {
 		etcdClient, err := etcd.Dial(etcdConf, agentInfo)
 		if err != nil {
@@ -93,6 +155,8 @@ func New(
 		}
 
 		coordinator = etcdClient
+		clusterSecret = etcdClient.ClusterSecret()
+		clusterInsecure = etcdConf.ClusterInsecure
 	} else {
 		coordinator = memory.NewCoordinator(agentInfo)
 	}
@@ -103,20 +167,114 @@ func New(
 		agentInfo.RPCAddr,
 	)
 
-	lruCache, err := cache.NewLRUExpireCache(authWebhookCacheSize)
+	lruCache, err := cache.NewLRUExpireCache(int(conf.AuthWebhookCacheSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var changeHookTimeout time.Duration
+	if conf.ChangeHookRequestTimeout != "" {
+		changeHookTimeout = conf.ParseChangeHookRequestTimeout()
+	}
+	changeHookClient, err := webhook.NewClient(webhook.ClientOptions{
+		Timeout: changeHookTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	changeHookSecret, err := readSigningSecret(conf.ChangeHookSigningSecretFile)
 	if err != nil {
 		return nil, err
 	}
+	changeHookQueues := make(map[string]*webhook.Queue)
+	for collection := range conf.ChangeHookURLs {
+		changeHookQueues[collection] = webhook.NewQueue(int(conf.ChangeHookQueueSize))
+	}
+
+	var docCache *cache.LRUExpireCache
+	if conf.DocCacheSize > 0 {
+		docCache, err = cache.NewLRUExpireCache(int(conf.DocCacheSize))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return &Backend{
+	ctx, cancel := context.WithCancel(context.Background())
+	be := &Backend{
 		Config:           conf,
 		agentInfo:        agentInfo,
 		DB:               mongoClient,
 		Coordinator:      coordinator,
 		Metrics:          metrics,
 		AuthWebhookCache: lruCache,
+		Schemas:          schemas,
+		DocCache:         docCache,
 		closing:          make(chan struct{}),
-	}, nil
+		ctx:              ctx,
+		cancel:           cancel,
+
+		AuthWebhookClient: authWebhookClient,
+		ChangeHookClient:  changeHookClient,
+		ChangeHookQueues:  changeHookQueues,
+		AuthWebhookSecret: authWebhookSecret,
+		ChangeHookSecret:  changeHookSecret,
+		ClusterSecret:     clusterSecret,
+		ClusterInsecure:   clusterInsecure,
+	}
+
+	for _, queue := range changeHookQueues {
+		queue := queue
+		be.AttachGoroutine(func() {
+			queue.Run(be.ctx)
+		})
+	}
+
+	return be, nil
+}
+
+// newAuthWebhookClient creates the HTTP client to call the authorization
+// webhook and reads the secret to sign the requests with the given config.
+func newAuthWebhookClient(conf *Config) (*http.Client, []byte, error) {
+	var timeout time.Duration
+	if conf.AuthWebhookRequestTimeout != "" {
+		timeout = conf.ParseAuthWebhookRequestTimeout()
+	}
+
+	client, err := webhook.NewClient(webhook.ClientOptions{
+		CAFile:   conf.AuthWebhookCAFile,
+		CertFile: conf.AuthWebhookCertFile,
+		KeyFile:  conf.AuthWebhookKeyFile,
+		Timeout:  timeout,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := readSigningSecret(conf.AuthWebhookSigningSecretFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, secret, nil
+}
+
+// readSigningSecret reads the secret to sign the webhook requests from the
+// given file. It returns nil if the path is empty.
+func readSigningSecret(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	secret, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("%s: empty signing secret", path)
+	}
+
+	return secret, nil
 }
 
 // Close closes all resources of this instance.
@@ -124,6 +282,7 @@ func (b *Backend) Close() error {
 	b.wgMu.Lock()
 	close(b.closing)
 	b.wgMu.Unlock()
+	b.cancel()
 
 	// wait for goroutines before closing backend
 	b.wg.Wait()
@@ -165,6 +324,13 @@ func (b *Backend) AttachGoroutine(f func()) {
 	}()
 }
 
+// Context returns the context of the backend, which is canceled when the
+// backend is closed. The goroutines attached to the backend use it to stop
+// waiting for external services on close.
+func (b *Backend) Context() context.Context {
+	return b.ctx
+}
+
 // Members returns the members of this cluster.
 func (b *Backend) Members() map[string]*sync.AgentInfo {
 	return b.Coordinator.Members()
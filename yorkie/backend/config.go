@@ -20,9 +20,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/pkg/types"
 )
 
+// snapshotCompressions is the compressions of snapshots by their names.
+var snapshotCompressions = map[string]api.SnapshotCompression{
+	"none": api.SnapshotCompression_NO_COMPRESSION,
+	"gzip": api.SnapshotCompression_GZIP,
+	"zstd": api.SnapshotCompression_ZSTD,
+}
+
 // Config is the configuration for creating a Backend instance.
 type Config struct {
 	// SnapshotThreshold is the threshold that determines if changes should be
@@ -32,6 +40,61 @@ type Config struct {
 	// SnapshotInterval is the interval of changes to create a snapshot.
 	SnapshotInterval uint64 `json:"SnapshotInterval"`
 
+	// SnapshotCompression is the compression of snapshots: "none", "gzip" or
+	// "zstd". Snapshots are stored with it, and sent with it to the clients
+	// that accept it. Agents that predate snapshot compression cannot read
+	// compressed snapshots, so it should be enabled only after every agent
+	// of the cluster is upgraded, and set back to "none" before rolling back.
+	SnapshotCompression string `json:"SnapshotCompression"`
+
+	// DocCacheSize is the max number of documents kept in memory to create
+	// snapshots and to validate pushed changes without decoding them from the
+	// database. Zero disables the document cache, and then every push decodes
+	// the document from the last snapshot to validate the changes.
+	DocCacheSize uint64 `json:"DocCacheSize"`
+
+	// SchemaFile is the path of the JSON file that maps collections to the
+	// schemas of their documents. If it is empty, documents are not validated.
+	// Changes are rejected only if they make new violations, so the documents
+	// created before the schema can still be changed.
+	SchemaFile string `json:"SchemaFile"`
+
+	// MaxOperationsPerChange is the max number of operations in a change.
+	// Zero means no limit.
+	MaxOperationsPerChange uint64 `json:"MaxOperationsPerChange"`
+
+	// MaxChangesPerPack is the max number of changes in a pack. Zero means no
+	// limit.
+	MaxChangesPerPack uint64 `json:"MaxChangesPerPack"`
+
+	// MaxDocumentSize is the max size of a document in bytes, measured by its
+	// snapshot. Zero means no limit.
+	MaxDocumentSize uint64 `json:"MaxDocumentSize"`
+
+	// MaxTextLength is the max length of a text in a document. Zero means no
+	// limit.
+	MaxTextLength uint64 `json:"MaxTextLength"`
+
+	// MaxArrayLength is the max number of elements of an array in a document.
+	// Zero means no limit.
+	MaxArrayLength uint64 `json:"MaxArrayLength"`
+
+	// MaxAttachedClientsPerDocument is the max number of clients that attach
+	// a document. Zero means no limit. If it is set, the attachments of a
+	// document are serialized by the lock of the document.
+	MaxAttachedClientsPerDocument uint64 `json:"MaxAttachedClientsPerDocument"`
+
+	// MaxBroadcastPayloadSize is the max size of the payload of a broadcast
+	// message in bytes.
+	MaxBroadcastPayloadSize uint64 `json:"MaxBroadcastPayloadSize"`
+
+	// AuditLogEnabled is whether to record who attached, detached, pushed
+	// changes to, watched, fetched or tailed the changes of which document,
+	// and who was denied the access, in the audit log. The entries are
+	// recorded before the access is served, and the access fails with
+	// Unavailable if they can not be recorded.
+	AuditLogEnabled bool `json:"AuditLogEnabled"`
+
 	// AuthWebhookURL is the url of the authorization webhook.
 	AuthWebhookURL string `json:"AuthWebhookURL"`
 
@@ -49,10 +112,89 @@ type Config struct {
 
 	// AuthWebhookCacheUnauthTTL is the TTL value to set when caching the unauthorized result.
 	AuthWebhookCacheUnauthTTL string `json:"AuthWebhookCacheUnauthTTL"`
+
+	// AuthWebhookCacheSize is the max number of the decisions of the
+	// authorization webhook cached per token and document key.
+	AuthWebhookCacheSize uint64 `json:"AuthWebhookCacheSize"`
+
+	// AuthWebhookRequestTimeout is the time limit of a request to the
+	// authorization webhook.
+	AuthWebhookRequestTimeout string `json:"AuthWebhookRequestTimeout"`
+
+	// AuthWebhookSigningSecretFile is the path of the file of the secret to
+	// sign the requests to the authorization webhook with HMAC-SHA256. If it is
+	// empty, the requests are not signed.
+	AuthWebhookSigningSecretFile string `json:"AuthWebhookSigningSecretFile"`
+
+	// AuthWebhookCAFile is the path of the PEM file of the CA certificates to
+	// verify the authorization webhook server. If it is empty, the system
+	// certificates are used.
+	AuthWebhookCAFile string `json:"AuthWebhookCAFile"`
+
+	// AuthWebhookCertFile is the path of the PEM file of the client
+	// certificate presented to the authorization webhook server.
+	AuthWebhookCertFile string `json:"AuthWebhookCertFile"`
+
+	// AuthWebhookKeyFile is the path of the PEM file of the key of the client
+	// certificate.
+	AuthWebhookKeyFile string `json:"AuthWebhookKeyFile"`
+
+	// AuthJWTHMACSecretFile is the path of the file of the HMAC secret to
+	// verify JWTs locally instead of the authorization webhook. The JWTs must
+	// have the "exp" claim.
+	AuthJWTHMACSecretFile string `json:"AuthJWTHMACSecretFile"`
+
+	// AuthJWTPublicKeyFile is the path of the PEM file of the RSA or ECDSA
+	// public key to verify JWTs.
+	AuthJWTPublicKeyFile string `json:"AuthJWTPublicKeyFile"`
+
+	// AuthJWTJWKSFile is the path of the JWKS file of the keys to verify JWTs.
+	AuthJWTJWKSFile string `json:"AuthJWTJWKSFile"`
+
+	// AuthJWTIssuer is the expected issuer of JWTs. If it is empty, the issuer
+	// is not checked.
+	AuthJWTIssuer string `json:"AuthJWTIssuer"`
+
+	// AuthJWTAudience is the expected audience of JWTs. If it is empty, the
+	// audience is not checked.
+	AuthJWTAudience string `json:"AuthJWTAudience"`
+
+	// ChangeHookURLs is the URLs of the change hooks by collection. The change
+	// hook is called when the documents of the collection are changed.
+	ChangeHookURLs map[string]string `json:"ChangeHookURLs"`
+
+	// ChangeHookIncludeOperations is whether to include the operations of the
+	// changes in the change hook requests.
+	ChangeHookIncludeOperations bool `json:"ChangeHookIncludeOperations"`
+
+	// ChangeHookMaxRetries is the max count that retries the change hook.
+	ChangeHookMaxRetries uint64 `json:"ChangeHookMaxRetries"`
+
+	// ChangeHookMaxWaitInterval is the max interval that waits before retrying the change hook.
+	ChangeHookMaxWaitInterval string `json:"ChangeHookMaxWaitInterval"`
+
+	// ChangeHookRequestTimeout is the time limit of a request to the change
+	// hook.
+	ChangeHookRequestTimeout string `json:"ChangeHookRequestTimeout"`
+
+	// ChangeHookQueueSize is the max number of the change hook requests
+	// waiting to be sent per collection. The requests of a collection are sent
+	// one by one in the order of the changes, and the requests beyond it are
+	// dropped while the change hook is slow.
+	ChangeHookQueueSize uint64 `json:"ChangeHookQueueSize"`
+
+	// ChangeHookSigningSecretFile is the path of the file of the secret to sign
+	// the requests to the change hooks with HMAC-SHA256. If it is empty, the
+	// requests are not signed.
+	ChangeHookSigningSecretFile string `json:"ChangeHookSigningSecretFile"`
 }
 
 // RequireAuth returns whether the given method require authorization.
 func (c *Config) RequireAuth(method types.Method) bool {
+	if c.AuthJWTEnabled() {
+		return true
+	}
+
 	if len(c.AuthWebhookURL) == 0 {
 		return false
 	}
@@ -70,6 +212,14 @@ func (c *Config) RequireAuth(method types.Method) bool {
 	return false
 }
 
+// AuthJWTEnabled returns whether JWTs are verified locally instead of the
+// authorization webhook.
+func (c *Config) AuthJWTEnabled() bool {
+	return len(c.AuthJWTHMACSecretFile) > 0 ||
+		len(c.AuthJWTPublicKeyFile) > 0 ||
+		len(c.AuthJWTJWKSFile) > 0
+}
+
 // Validate validates this config.
 func (c *Config) Validate() error {
 	for _, method := range c.AuthWebhookMethods {
@@ -78,6 +228,17 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.AuthJWTEnabled() && len(c.AuthWebhookURL) > 0 {
+		return fmt.Errorf("authorization webhook and JWT verification can not be used together")
+	}
+
+	if _, ok := snapshotCompressions[c.SnapshotCompression]; !ok {
+		return fmt.Errorf(
+			"invalid argument \"%s\" for \"--backend-snapshot-compression\" flag",
+			c.SnapshotCompression,
+		)
+	}
+
 	if _, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval); err != nil {
 		return fmt.Errorf(
 			"invalid argument \"%s\" for \"--auth-webhook-max-wait-interval\" flag: %w",
@@ -102,9 +263,69 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.MaxBroadcastPayloadSize == 0 {
+		return fmt.Errorf(
+			"invalid argument \"%d\" for \"--backend-max-broadcast-payload-size\" flag: must be positive",
+			c.MaxBroadcastPayloadSize,
+		)
+	}
+
+	if c.AuthWebhookCacheSize == 0 {
+		return fmt.Errorf(
+			"invalid argument \"%d\" for \"--auth-webhook-cache-size\" flag: must be positive",
+			c.AuthWebhookCacheSize,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.AuthWebhookRequestTimeout); err != nil {
+		return fmt.Errorf(
+			"invalid argument \"%s\" for \"--auth-webhook-request-timeout\" flag: %w",
+			c.AuthWebhookRequestTimeout,
+			err,
+		)
+	}
+
+	if (len(c.AuthWebhookCertFile) == 0) != (len(c.AuthWebhookKeyFile) == 0) {
+		return fmt.Errorf(
+			"\"--auth-webhook-cert-file\" and \"--auth-webhook-key-file\" flags should be set together",
+		)
+	}
+
+	if _, err := time.ParseDuration(c.ChangeHookMaxWaitInterval); err != nil {
+		return fmt.Errorf(
+			"invalid argument \"%s\" for \"--change-hook-max-wait-interval\" flag: %w",
+			c.ChangeHookMaxWaitInterval,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.ChangeHookRequestTimeout); err != nil {
+		return fmt.Errorf(
+			"invalid argument \"%s\" for \"--change-hook-request-timeout\" flag: %w",
+			c.ChangeHookRequestTimeout,
+			err,
+		)
+	}
+
 	return nil
 }
 
+// ChangeHookURL returns the URL of the change hook of the given collection.
+func (c *Config) ChangeHookURL(collection string) (string, bool) {
+	url, ok := c.ChangeHookURLs[collection]
+	return url, ok && len(url) > 0
+}
+
+// ParseSnapshotCompression returns the compression of snapshots.
+func (c *Config) ParseSnapshotCompression() api.SnapshotCompression {
+	compression, ok := snapshotCompressions[c.SnapshotCompression]
+	if !ok {
+		panic(fmt.Sprintf("invalid snapshot compression: %s", c.SnapshotCompression))
+	}
+
+	return compression
+}
+
 // ParseAuthWebhookMaxWaitInterval returns max wait interval.
 func (c *Config) ParseAuthWebhookMaxWaitInterval() time.Duration {
 	result, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval)
@@ -134,3 +355,35 @@ func (c *Config) ParseAuthWebhookCacheUnauthTTL() time.Duration {
 
 	return result
 }
+
+// ParseAuthWebhookRequestTimeout returns the time limit of a request to the
+// authorization webhook.
+func (c *Config) ParseAuthWebhookRequestTimeout() time.Duration {
+	result, err := time.ParseDuration(c.AuthWebhookRequestTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseChangeHookMaxWaitInterval returns max wait interval of the change hook.
+func (c *Config) ParseChangeHookMaxWaitInterval() time.Duration {
+	result, err := time.ParseDuration(c.ChangeHookMaxWaitInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseChangeHookRequestTimeout returns the time limit of a request to the
+// change hook.
+func (c *Config) ParseChangeHookRequestTimeout() time.Duration {
+	result, err := time.ParseDuration(c.ChangeHookRequestTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
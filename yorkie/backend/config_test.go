@@ -32,15 +32,28 @@ func TestConfig(t *testing.T) {
 			AuthWebhookURL: "",
 		}
 		assert.False(t, conf3.RequireAuth(types.ActivateClient))
+
+		// 4. Verify JWTs locally
+		conf4 := backend.Config{
+			AuthJWTHMACSecretFile: "secret",
+		}
+		assert.True(t, conf4.RequireAuth(types.ActivateClient))
+		assert.True(t, conf4.RequireAuth(types.PushPull))
 	})
 
 	t.Run("validate test", func(t *testing.T) {
 		// 1.Success
 		validConf := backend.Config{
+			SnapshotCompression:        "zstd",
 			AuthWebhookMethods:         []string{"ActivateClient"},
 			AuthWebhookMaxWaitInterval: "0ms",
 			AuthWebhookCacheAuthTTL:    "10s",
 			AuthWebhookCacheUnauthTTL:  "10s",
+			AuthWebhookCacheSize:       5000,
+			MaxBroadcastPayloadSize:    64 * 1024,
+			AuthWebhookRequestTimeout:  "10s",
+			ChangeHookMaxWaitInterval:  "0ms",
+			ChangeHookRequestTimeout:   "10s",
 		}
 		assert.NoError(t, validConf.Validate())
 
@@ -63,5 +76,46 @@ func TestConfig(t *testing.T) {
 		conf4 := validConf
 		conf4.AuthWebhookCacheUnauthTTL = "s"
 		assert.Error(t, conf4.Validate())
+
+		// 5. Invalid ChangeHookMaxWaitInterval
+		conf5 := validConf
+		conf5.ChangeHookMaxWaitInterval = "5"
+		assert.Error(t, conf5.Validate())
+
+		// 6. Invalid SnapshotCompression
+		conf6 := validConf
+		conf6.SnapshotCompression = "lz4"
+		assert.Error(t, conf6.Validate())
+
+		// 7. Both of the webhook and JWT verification
+		conf7 := validConf
+		conf7.AuthWebhookURL = "ValidWebhookURL"
+		conf7.AuthJWTJWKSFile = "jwks.json"
+		assert.Error(t, conf7.Validate())
+
+		// 8. Invalid AuthWebhookRequestTimeout
+		conf8 := validConf
+		conf8.AuthWebhookRequestTimeout = "s"
+		assert.Error(t, conf8.Validate())
+
+		// 9. Client certificate without the key
+		conf9 := validConf
+		conf9.AuthWebhookCertFile = "cert.pem"
+		assert.Error(t, conf9.Validate())
+
+		// 10. Zero AuthWebhookCacheSize
+		conf10 := validConf
+		conf10.AuthWebhookCacheSize = 0
+		assert.Error(t, conf10.Validate())
+
+		// 11. Zero MaxBroadcastPayloadSize
+		conf11 := validConf
+		conf11.MaxBroadcastPayloadSize = 0
+		assert.Error(t, conf11.Validate())
+
+		// 12. Invalid ChangeHookRequestTimeout
+		conf12 := validConf
+		conf12.ChangeHookRequestTimeout = "10"
+		assert.Error(t, conf12.Validate())
 	})
 }
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db
+
+import (
+	gotime "time"
+)
+
+// AuditLog is a structure representing an entry of the audit log, which
+// records who accessed or mutated which document and when, and who was denied
+// the access. The entries are only appended and never updated.
+type AuditLog struct {
+	ID         ID          `bson:"_id"`
+	Subject    string      `bson:"subject"`
+	ClientID   ID          `bson:"client_id"`
+	Method     string      `bson:"method"`
+	DocKey     string      `bson:"doc_key"`
+	Operations int         `bson:"operations"`
+	Denied     bool        `bson:"denied"`
+	CreatedAt  gotime.Time `bson:"created_at"`
+}
+
+// AuditLogFilter is the condition to find the entries of the audit log. The
+// empty fields are not used as conditions.
+type AuditLogFilter struct {
+	Subject  string
+	ClientID ID
+	Method   string
+	DocKey   string
+
+	// DeniedOnly is whether to find only the entries of the denied accesses.
+	DeniedOnly bool
+
+	// Since and Until are the range of the times that the entries are created
+	// in. Since is inclusive and Until is exclusive.
+	Since gotime.Time
+	Until gotime.Time
+
+	// Limit is the max number of the entries to find. Zero means no limit.
+	Limit int64
+}
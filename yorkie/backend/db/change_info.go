@@ -18,6 +18,9 @@ package db
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -27,15 +30,23 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ErrInvalidChangeCursor is returned when the given cursor is not valid.
+var ErrInvalidChangeCursor = errors.New("invalid change cursor")
+
 // ChangeInfo is a structure representing information of a change.
 type ChangeInfo struct {
-	DocID      ID       `bson:"doc_id"`
-	ServerSeq  uint64   `bson:"server_seq"`
-	ClientSeq  uint32   `bson:"client_seq"`
-	Lamport    uint64   `bson:"lamport"`
-	Actor      ID       `bson:"actor"`
-	Message    string   `bson:"message"`
-	Operations [][]byte `bson:"operations"`
+	ID            ID          `bson:"_id"`
+	DocID         ID          `bson:"doc_id"`
+	DocKey        string      `bson:"doc_key"`
+	Collection    string      `bson:"collection"`
+	CollectionSeq uint64      `bson:"collection_seq"`
+	StoredAt      gotime.Time `bson:"stored_at"`
+	ServerSeq     uint64      `bson:"server_seq"`
+	ClientSeq     uint32      `bson:"client_seq"`
+	Lamport       uint64      `bson:"lamport"`
+	Actor         ID          `bson:"actor"`
+	Message       string      `bson:"message"`
+	Operations    [][]byte    `bson:"operations"`
 }
 
 // EncodeOperations encodes the given operations into bytes array.
@@ -88,3 +99,30 @@ func (i *ChangeInfo) ToChange() (*change.Change, error) {
 
 	return c, nil
 }
+
+// Cursor returns the cursor that points to this change in the stream of the
+// stored changes of its collection.
+func (i *ChangeInfo) Cursor() ChangeCursor {
+	return ChangeCursor{CollectionSeq: i.CollectionSeq}
+}
+
+// ChangeCursor is a position in the stream of the stored changes of a
+// collection, which are ordered by their collection seqs.
+type ChangeCursor struct {
+	CollectionSeq uint64
+}
+
+// ParseChangeCursor parses the given string representation of a cursor.
+func ParseChangeCursor(str string) (ChangeCursor, error) {
+	seq, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return ChangeCursor{}, fmt.Errorf("%s: %w", str, ErrInvalidChangeCursor)
+	}
+
+	return ChangeCursor{CollectionSeq: seq}, nil
+}
+
+// String returns the string representation of this cursor.
+func (c ChangeCursor) String() string {
+	return strconv.FormatUint(c.CollectionSeq, 10)
+}
@@ -25,4 +25,23 @@ func TestChangeInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, change.ID().Actor().String(), expectedID)
 	})
+
+	t.Run("change cursor test", func(t *testing.T) {
+		info := db.ChangeInfo{
+			ID:            db.ID("0123456789abcdef01234567"),
+			Collection:    "todos",
+			CollectionSeq: 7,
+		}
+
+		cursor, err := db.ParseChangeCursor(info.Cursor().String())
+		assert.NoError(t, err)
+		assert.Equal(t, info.Cursor(), cursor)
+
+		_, err = db.ParseChangeCursor("invalid")
+		assert.ErrorIs(t, err, db.ErrInvalidChangeCursor)
+
+		// cursors in the timestamp format are no longer accepted.
+		_, err = db.ParseChangeCursor("1634000000.7-0123456789abcdef01234567")
+		assert.ErrorIs(t, err, db.ErrInvalidChangeCursor)
+	})
 }
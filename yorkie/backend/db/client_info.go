@@ -29,6 +29,7 @@ var (
 	ErrDocumentNotAttached     = errors.New("document not attached")
 	ErrDocumentNeverAttached   = errors.New("client has never attached the document")
 	ErrDocumentAlreadyAttached = errors.New("document already attached")
+	ErrClientSubjectMismatch   = errors.New("client activated by another subject")
 )
 
 // Below are statuses of the client.
@@ -37,9 +38,10 @@ const (
 	ClientActivated   = "activated"
 )
 
+// Below are statuses of the document in the client.
 const (
-	documentAttached = "attached"
-	documentDetached = "detached"
+	DocumentAttached = "attached"
+	DocumentDetached = "detached"
 )
 
 // ClientDocInfo is a structure representing information of the document
@@ -48,6 +50,7 @@ type ClientDocInfo struct {
 	Status    string `bson:"status"`
 	ServerSeq uint64 `bson:"server_seq"`
 	ClientSeq uint32 `bson:"client_seq"`
+	Lamport   uint64 `bson:"lamport"`
 }
 
 // ClientInfo is a structure representing information of a client.
@@ -55,6 +58,7 @@ type ClientInfo struct {
 	ID        ID                    `bson:"_id"`
 	Key       string                `bson:"key"`
 	Status    string                `bson:"status"`
+	Subject   string                `bson:"subject"`
 	Documents map[ID]*ClientDocInfo `bson:"documents"`
 	CreatedAt time.Time             `bson:"created_at"`
 	UpdatedAt time.Time             `bson:"updated_at"`
@@ -70,12 +74,12 @@ func (i *ClientInfo) AttachDocument(docID ID) error {
 		i.Documents = make(map[ID]*ClientDocInfo)
 	}
 
-	if i.hasDocument(docID) && i.Documents[docID].Status == documentAttached {
+	if i.hasDocument(docID) && i.Documents[docID].Status == DocumentAttached {
 		return ErrDocumentAlreadyAttached
 	}
 
 	i.Documents[docID] = &ClientDocInfo{
-		Status:    documentAttached,
+		Status:    DocumentAttached,
 		ServerSeq: 0,
 		ClientSeq: 0,
 	}
@@ -90,9 +94,10 @@ func (i *ClientInfo) DetachDocument(docID ID) error {
 		return err
 	}
 
-	i.Documents[docID].Status = documentDetached
+	i.Documents[docID].Status = DocumentDetached
 	i.Documents[docID].ClientSeq = 0
 	i.Documents[docID].ServerSeq = 0
+	i.Documents[docID].Lamport = 0
 	i.UpdatedAt = time.Now()
 
 	return nil
@@ -104,7 +109,7 @@ func (i *ClientInfo) IsAttached(docID ID) (bool, error) {
 		return false, ErrDocumentNeverAttached
 	}
 
-	return i.Documents[docID].Status == documentAttached, nil
+	return i.Documents[docID].Status == DocumentAttached, nil
 }
 
 // Checkpoint returns the checkpoint of the given document.
@@ -133,13 +138,50 @@ func (i *ClientInfo) UpdateCheckpoint(
 	return nil
 }
 
+// Lamport returns the lamport of the last change of the given document pushed
+// by this client since the document was attached.
+func (i *ClientInfo) Lamport(docID ID) uint64 {
+	clientDocInfo := i.Documents[docID]
+	if clientDocInfo == nil {
+		return 0
+	}
+
+	return clientDocInfo.Lamport
+}
+
+// UpdateLamport updates the lamport of the last change of the given document
+// pushed by this client.
+func (i *ClientInfo) UpdateLamport(docID ID, lamport uint64) error {
+	if !i.hasDocument(docID) {
+		return ErrDocumentNeverAttached
+	}
+
+	if i.Documents[docID].Lamport < lamport {
+		i.Documents[docID].Lamport = lamport
+	}
+	i.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// EnsureSubject ensures this client can be used by the given subject. The
+// client activated without a subject should be bound to the subject that
+// uses it first, see BindClientSubject of the database.
+func (i *ClientInfo) EnsureSubject(subject string) error {
+	if i.Subject != "" && i.Subject != subject {
+		return ErrClientSubjectMismatch
+	}
+
+	return nil
+}
+
 // EnsureDocumentAttached ensures the given document is attached.
 func (i *ClientInfo) EnsureDocumentAttached(docID ID) error {
 	if i.Status != ClientActivated {
 		return ErrClientNotActivated
 	}
 
-	if !i.hasDocument(docID) || i.Documents[docID].Status == documentDetached {
+	if !i.hasDocument(docID) || i.Documents[docID].Status == DocumentDetached {
 		return ErrDocumentNotAttached
 	}
 
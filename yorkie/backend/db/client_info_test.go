@@ -25,10 +25,26 @@ func TestClientInfo(t *testing.T) {
 		err = clientInfo.UpdateCheckpoint(docID, checkpoint.Max)
 		assert.NoError(t, err)
 
+		assert.NoError(t, clientInfo.UpdateLamport(docID, 10))
+		assert.NoError(t, clientInfo.UpdateLamport(docID, 5))
+		assert.Equal(t, uint64(10), clientInfo.Lamport(docID))
+
 		err = clientInfo.DetachDocument(docID)
 		assert.NoError(t, err)
 		isAttached, err = clientInfo.IsAttached(docID)
 		assert.NoError(t, err)
 		assert.False(t, isAttached)
+		assert.Equal(t, uint64(0), clientInfo.Lamport(docID))
+	})
+
+	t.Run("ensure subject test", func(t *testing.T) {
+		unbound := db.ClientInfo{Status: db.ClientActivated}
+		assert.NoError(t, unbound.EnsureSubject(""))
+		assert.NoError(t, unbound.EnsureSubject("alice"))
+
+		bound := db.ClientInfo{Status: db.ClientActivated, Subject: "alice"}
+		assert.NoError(t, bound.EnsureSubject("alice"))
+		assert.ErrorIs(t, bound.EnsureSubject("bob"), db.ErrClientSubjectMismatch)
+		assert.ErrorIs(t, bound.EnsureSubject(""), db.ErrClientSubjectMismatch)
 	})
 }
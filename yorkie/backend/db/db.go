@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	gotime "time"
 
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -51,8 +51,10 @@ type DB interface {
 	// Close all resources of this database.
 	Close() error
 
-	// ActivateClient activates the client of the given key.
-	ActivateClient(ctx context.Context, key string) (*ClientInfo, error)
+	// ActivateClient activates the client of the given key. If the subject
+	// is given, the client is bound to it. The client bound to another
+	// subject is not activated, even if the subject is not given.
+	ActivateClient(ctx context.Context, key string, subject string) (*ClientInfo, error)
 
 	// DeactivateClient deactivates the client of the given ID.
 	DeactivateClient(ctx context.Context, clientID ID) (*ClientInfo, error)
@@ -60,6 +62,11 @@ type DB interface {
 	// FindClientInfoByID finds the client of the given ID.
 	FindClientInfoByID(ctx context.Context, clientID ID) (*ClientInfo, error)
 
+	// BindClientSubject binds the client of the given ID, activated without a
+	// subject, to the given subject. It fails if the client is already bound
+	// to another subject.
+	BindClientSubject(ctx context.Context, clientID ID, subject string) error
+
 	// UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 	// after handling PushPull.
 	UpdateClientInfoAfterPushPull(ctx context.Context, clientInfo *ClientInfo, docInfo *DocInfo) error
@@ -74,7 +81,18 @@ type DB interface {
 		createDocIfNotExist bool,
 	) (*DocInfo, error)
 
+	// FindDocInfoByKeyReadOnly finds the document of the given key without
+	// creating it or updating its access time.
+	FindDocInfoByKeyReadOnly(ctx context.Context, bsonDocKey string) (*DocInfo, error)
+
+	// FindAttachedClientCount returns the number of the activated clients
+	// that attach the document of the given ID.
+	FindAttachedClientCount(ctx context.Context, docID ID) (int64, error)
+
 	// StoreChangeInfos stores the given changes then updates the given docInfo.
+	// The changes are given the next collection seqs of the collection of the
+	// document, so the caller should hold the lock of the changes of the
+	// collection for the changes to be visible in the order of the seqs.
 	StoreChangeInfos(
 		ctx context.Context,
 		docInfo *DocInfo,
@@ -82,8 +100,14 @@ type DB interface {
 		changes []*change.Change,
 	) error
 
-	// CreateSnapshotInfo stores the snapshot of the given document.
-	CreateSnapshotInfo(ctx context.Context, docID ID, doc *document.InternalDocument) error
+	// CreateSnapshotInfo stores the given encoded snapshot of the document at
+	// the given server seq.
+	CreateSnapshotInfo(
+		ctx context.Context,
+		docID ID,
+		serverSeq uint64,
+		snapshot []byte,
+	) error
 
 	// FindChangesBetweenServerSeqs returns the changes between two server sequences.
 	FindChangesBetweenServerSeqs(
@@ -101,6 +125,26 @@ type DB interface {
 		to uint64,
 	) ([]*ChangeInfo, error)
 
+	// FindChangeInfosAfterCursor returns the changes of the given collection
+	// that were stored after the given cursor, in the order of their
+	// collection seqs.
+	FindChangeInfosAfterCursor(
+		ctx context.Context,
+		collection string,
+		cursor ChangeCursor,
+		limit int64,
+	) ([]*ChangeInfo, error)
+
+	// FindChangeCursorByTime returns the cursor right before the first change
+	// of the given collection stored at or after the given time, or the cursor
+	// of the last change if there is none. The caller should hold the lock of
+	// the changes of the collection so that no change is being stored.
+	FindChangeCursorByTime(
+		ctx context.Context,
+		collection string,
+		storedAt gotime.Time,
+	) (ChangeCursor, error)
+
 	// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
 	// and returns the min synced ticket.
 	UpdateAndFindMinSyncedTicket(
@@ -112,4 +156,19 @@ type DB interface {
 
 	// FindLastSnapshotInfo finds the last snapshot of the given document.
 	FindLastSnapshotInfo(ctx context.Context, docID ID) (*SnapshotInfo, error)
+
+	// FindLastSnapshotMeta finds the metadata of the last snapshot of the
+	// given document. The snapshot itself is not loaded.
+	FindLastSnapshotMeta(ctx context.Context, docID ID) (*SnapshotInfo, error)
+
+	// FindClosestSnapshotInfo finds the last snapshot of the given document
+	// that is not after the given server seq.
+	FindClosestSnapshotInfo(ctx context.Context, docID ID, serverSeq uint64) (*SnapshotInfo, error)
+
+	// CreateAuditLogs appends the given entries to the audit log.
+	CreateAuditLogs(ctx context.Context, logs []*AuditLog) error
+
+	// FindAuditLogs returns the entries of the audit log that match the given
+	// filter, in the created order.
+	FindAuditLogs(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error)
 }
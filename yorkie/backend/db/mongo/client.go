@@ -22,14 +22,14 @@ import (
 	gotime "time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
-	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 )
@@ -89,20 +89,37 @@ func (c *Client) Close() error {
 	return nil
 }
 
-// ActivateClient activates the client of the given key.
-func (c *Client) ActivateClient(ctx context.Context, key string) (*db.ClientInfo, error) {
+// ActivateClient activates the client of the given key. If the subject is
+// given, the client is bound to it. The client bound to another subject is not
+// activated, even if the subject is not given.
+func (c *Client) ActivateClient(ctx context.Context, key string, subject string) (*db.ClientInfo, error) {
 	clientInfo := db.ClientInfo{}
 
 	now := gotime.Now()
-	res, err := c.collection(ColClients).UpdateOne(ctx, bson.M{
+
+	// NOTE: The client bound to another subject is not matched, so the upsert
+	//       fails with the duplicate key of the client.
+	filter := bson.M{
 		"key": key,
-	}, bson.M{
-		"$set": bson.M{
-			"status":     db.ClientActivated,
-			"updated_at": now,
+		"subject": bson.M{
+			"$in": bson.A{subject, "", nil},
 		},
+	}
+	fields := bson.M{
+		"status":     db.ClientActivated,
+		"updated_at": now,
+	}
+	if subject != "" {
+		fields["subject"] = subject
+	}
+
+	res, err := c.collection(ColClients).UpdateOne(ctx, filter, bson.M{
+		"$set": fields,
 	}, options.Update().SetUpsert(true))
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("%s: %w", key, db.ErrClientSubjectMismatch)
+		}
 		log.Logger.Error(err)
 		return nil, err
 	}
@@ -176,6 +193,34 @@ func (c *Client) FindClientInfoByID(ctx context.Context, clientID db.ID) (*db.Cl
 	return &clientInfo, nil
 }
 
+// BindClientSubject binds the client of the given ID, activated without a
+// subject, to the given subject. It fails if the client is already bound to
+// another subject.
+func (c *Client) BindClientSubject(ctx context.Context, clientID db.ID, subject string) error {
+	encodedClientID, err := encodeID(clientID)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.collection(ColClients).UpdateOne(ctx, bson.M{
+		"_id":     encodedClientID,
+		"subject": bson.M{"$in": bson.A{subject, "", nil}},
+	}, bson.M{
+		"$set": bson.M{
+			"subject": subject,
+		},
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", clientID, db.ErrClientSubjectMismatch)
+	}
+
+	return nil
+}
+
 // UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 // after handling PushPull.
 func (c *Client) UpdateClientInfoAfterPushPull(
@@ -190,6 +235,7 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 		"$max": bson.M{
 			clientDocInfoKey + "server_seq": clientDocInfo.ServerSeq,
 			clientDocInfoKey + "client_seq": clientDocInfo.ClientSeq,
+			clientDocInfoKey + "lamport":    clientDocInfo.Lamport,
 		},
 		"$set": bson.M{
 			clientDocInfoKey + "status": clientDocInfo.Status,
@@ -207,6 +253,7 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 			"$set": bson.M{
 				clientDocInfoKey + "server_seq": 0,
 				clientDocInfoKey + "client_seq": 0,
+				clientDocInfoKey + "lamport":    0,
 				clientDocInfoKey + "status":     clientDocInfo.Status,
 				"updated_at":                    clientInfo.UpdatedAt,
 			},
@@ -287,6 +334,88 @@ func (c *Client) FindDocInfoByKey(
 	return &docInfo, nil
 }
 
+// FindDocInfoByKeyReadOnly finds the docInfo of the given key without
+// creating it or updating its access time.
+func (c *Client) FindDocInfoByKeyReadOnly(
+	ctx context.Context,
+	bsonDocKey string,
+) (*db.DocInfo, error) {
+	result := c.collection(ColDocuments).FindOne(ctx, bson.M{
+		"key": bsonDocKey,
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("%s: %w", bsonDocKey, db.ErrDocumentNotFound)
+	}
+	if result.Err() != nil {
+		log.Logger.Error(result.Err())
+		return nil, result.Err()
+	}
+
+	docInfo := db.DocInfo{}
+	if err := result.Decode(&docInfo); err != nil {
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
+// FindAttachedClientCount returns the number of the activated clients that
+// attach the document of the given ID. The clients are looked up by the synced
+// seqs of the document, so that the count does not scan the clients.
+func (c *Client) FindAttachedClientCount(
+	ctx context.Context,
+	docID db.ID,
+) (int64, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := c.collection(ColSyncedSeqs).Find(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}, options.Find().SetProjection(bson.M{
+		"client_id": 1,
+	}))
+	if err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	var syncedSeqInfos []*db.SyncedSeqInfo
+	if err := cursor.All(ctx, &syncedSeqInfos); err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+	if len(syncedSeqInfos) == 0 {
+		return 0, nil
+	}
+
+	var clientIDs []primitive.ObjectID
+	for _, info := range syncedSeqInfos {
+		clientID, err := encodeID(info.ClientID)
+		if err != nil {
+			return 0, err
+		}
+		clientIDs = append(clientIDs, clientID)
+	}
+
+	// NOTE: The synced seqs of the deactivated clients can be left, so the
+	//       clients are checked whether they still attach the document.
+	count, err := c.collection(ColClients).CountDocuments(ctx, bson.M{
+		"_id": bson.M{
+			"$in": clientIDs,
+		},
+		"status": db.ClientActivated,
+		"documents." + docID.String() + ".status": db.DocumentAttached,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // StoreChangeInfos stores the given changes and doc info.
 func (c *Client) StoreChangeInfos(
 	ctx context.Context,
@@ -299,8 +428,22 @@ func (c *Client) StoreChangeInfos(
 		return err
 	}
 
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: A change stored again gets a new collection seq, so it is
+	//       delivered again by WatchChanges, which delivers changes at least
+	//       once.
+	firstCollectionSeq, err := c.nextCollectionSeqs(ctx, docKey.Collection, len(changes))
+	if err != nil {
+		return err
+	}
+	storedAt := gotime.Now()
+
 	var models []mongo.WriteModel
-	for _, cn := range changes {
+	for i, cn := range changes {
 		encodedOperations, err := db.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
@@ -309,13 +452,19 @@ func (c *Client) StoreChangeInfos(
 		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{
 			"doc_id":     encodedDocID,
 			"server_seq": cn.ServerSeq(),
-		}).SetUpdate(bson.M{"$set": bson.M{
-			"actor":      encodeActorID(cn.ID().Actor()),
-			"client_seq": cn.ID().ClientSeq(),
-			"lamport":    cn.ID().Lamport(),
-			"message":    cn.Message(),
-			"operations": encodedOperations,
-		}}).SetUpsert(true))
+		}).SetUpdate(bson.M{
+			"$set": bson.M{
+				"doc_key":        docInfo.Key,
+				"collection":     docKey.Collection,
+				"collection_seq": firstCollectionSeq + uint64(i),
+				"stored_at":      storedAt,
+				"actor":          encodeActorID(cn.ID().Actor()),
+				"client_seq":     cn.ID().ClientSeq(),
+				"lamport":        cn.ID().Lamport(),
+				"message":        cn.Message(),
+				"operations":     encodedOperations,
+			},
+		}).SetUpsert(true))
 	}
 
 	// TODO(hackerwins): We need to handle the updates for the two collections
@@ -349,24 +498,22 @@ func (c *Client) StoreChangeInfos(
 	return nil
 }
 
-// CreateSnapshotInfo stores the snapshot of the given document.
+// CreateSnapshotInfo stores the given encoded snapshot of the document at the
+// given server seq.
 func (c *Client) CreateSnapshotInfo(
 	ctx context.Context,
 	docID db.ID,
-	doc *document.InternalDocument,
+	serverSeq uint64,
+	snapshot []byte,
 ) error {
 	encodedDocID, err := encodeID(docID)
 	if err != nil {
 		return err
 	}
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
-	if err != nil {
-		return err
-	}
 
 	if _, err := c.collection(ColSnapshots).InsertOne(ctx, bson.M{
 		"doc_id":     encodedDocID,
-		"server_seq": doc.Checkpoint().ServerSeq,
+		"server_seq": serverSeq,
 		"snapshot":   snapshot,
 		"created_at": gotime.Now(),
 	}); err != nil {
@@ -434,6 +581,118 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 	return infos, nil
 }
 
+// FindChangeInfosAfterCursor returns the changes of the given collection that
+// were stored after the given cursor, in the order of their collection seqs.
+func (c *Client) FindChangeInfosAfterCursor(
+	ctx context.Context,
+	collection string,
+	cursor db.ChangeCursor,
+	limit int64,
+) ([]*db.ChangeInfo, error) {
+	var infos []*db.ChangeInfo
+	result, err := c.collection(ColChanges).Find(ctx, bson.M{
+		"collection": collection,
+		"collection_seq": bson.M{
+			"$gt": cursor.CollectionSeq,
+		},
+	}, options.Find().SetSort(bson.M{
+		"collection_seq": 1,
+	}).SetLimit(limit))
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	if err := result.All(ctx, &infos); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// FindChangeCursorByTime returns the cursor right before the first change of
+// the given collection stored at or after the given time, or the cursor of the
+// last change if there is none.
+func (c *Client) FindChangeCursorByTime(
+	ctx context.Context,
+	collection string,
+	storedAt gotime.Time,
+) (db.ChangeCursor, error) {
+	info := &db.ChangeInfo{}
+	result := c.collection(ColChanges).FindOne(ctx, bson.M{
+		"collection": collection,
+		"stored_at": bson.M{
+			"$gte": storedAt,
+		},
+	}, options.FindOne().SetSort(bson.M{
+		"stored_at": 1,
+	}))
+	if result.Err() == mongo.ErrNoDocuments {
+		lastSeq, err := c.lastCollectionSeq(ctx, collection)
+		if err != nil {
+			return db.ChangeCursor{}, err
+		}
+		return db.ChangeCursor{CollectionSeq: lastSeq}, nil
+	}
+	if result.Err() != nil {
+		log.Logger.Error(result.Err())
+		return db.ChangeCursor{}, result.Err()
+	}
+
+	if err := result.Decode(info); err != nil {
+		log.Logger.Error(err)
+		return db.ChangeCursor{}, err
+	}
+
+	return db.ChangeCursor{CollectionSeq: info.CollectionSeq - 1}, nil
+}
+
+// nextCollectionSeqs reserves the given number of the next collection seqs of
+// the given collection, and returns the first of them.
+func (c *Client) nextCollectionSeqs(
+	ctx context.Context,
+	collection string,
+	count int,
+) (uint64, error) {
+	var counter struct {
+		Seq uint64 `bson:"seq"`
+	}
+	if err := c.collection(ColCollectionSeqs).FindOneAndUpdate(ctx, bson.M{
+		"collection": collection,
+	}, bson.M{
+		"$inc": bson.M{"seq": int64(count)},
+	}, options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After),
+	).Decode(&counter); err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	return counter.Seq - uint64(count) + 1, nil
+}
+
+// lastCollectionSeq returns the last collection seq given to the changes of
+// the given collection.
+func (c *Client) lastCollectionSeq(ctx context.Context, collection string) (uint64, error) {
+	var counter struct {
+		Seq uint64 `bson:"seq"`
+	}
+	result := c.collection(ColCollectionSeqs).FindOne(ctx, bson.M{
+		"collection": collection,
+	})
+	if result.Err() == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	if err := result.Decode(&counter); err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	return counter.Seq, nil
+}
+
 // UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
 // and returns the min synced ticket.
 func (c *Client) UpdateAndFindMinSyncedTicket(
@@ -521,10 +780,56 @@ func (c *Client) FindLastSnapshotInfo(
 		return nil, err
 	}
 
-	snapshotInfo := &db.SnapshotInfo{}
-	result := c.collection(ColSnapshots).FindOne(ctx, bson.M{
+	return c.findLastSnapshotInfo(ctx, bson.M{
 		"doc_id": encodedDocID,
-	}, options.FindOne().SetSort(bson.M{
+	}, options.FindOne())
+}
+
+// FindLastSnapshotMeta finds the metadata of the last snapshot of the given
+// document. The snapshot itself is not loaded.
+func (c *Client) FindLastSnapshotMeta(
+	ctx context.Context,
+	docID db.ID,
+) (*db.SnapshotInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.findLastSnapshotInfo(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}, options.FindOne().SetProjection(bson.M{
+		"snapshot": 0,
+	}))
+}
+
+// FindClosestSnapshotInfo finds the last snapshot of the given document that
+// is not after the given server seq.
+func (c *Client) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
+) (*db.SnapshotInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.findLastSnapshotInfo(ctx, bson.M{
+		"doc_id": encodedDocID,
+		"server_seq": bson.M{
+			"$lte": serverSeq,
+		},
+	}, options.FindOne())
+}
+
+func (c *Client) findLastSnapshotInfo(
+	ctx context.Context,
+	filter bson.M,
+	opts *options.FindOneOptions,
+) (*db.SnapshotInfo, error) {
+	snapshotInfo := &db.SnapshotInfo{}
+	result := c.collection(ColSnapshots).FindOne(ctx, filter, opts.SetSort(bson.M{
 		"server_seq": -1,
 	}))
 
@@ -544,6 +849,102 @@ func (c *Client) FindLastSnapshotInfo(
 	return snapshotInfo, nil
 }
 
+// CreateAuditLogs appends the given entries to the audit log.
+func (c *Client) CreateAuditLogs(ctx context.Context, logs []*db.AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	var models []interface{}
+	for _, l := range logs {
+		model := bson.M{
+			"subject":    l.Subject,
+			"method":     l.Method,
+			"doc_key":    l.DocKey,
+			"operations": l.Operations,
+			"denied":     l.Denied,
+			"created_at": l.CreatedAt,
+		}
+
+		// NOTE: The accesses without activation, such as FetchDocument, are
+		//       recorded without the client.
+		if l.ClientID != "" {
+			encodedClientID, err := encodeID(l.ClientID)
+			if err != nil {
+				return err
+			}
+			model["client_id"] = encodedClientID
+		}
+
+		models = append(models, model)
+	}
+
+	if _, err := c.collection(ColAuditLogs).InsertMany(ctx, models); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// FindAuditLogs returns the entries of the audit log that match the given
+// filter, in the created order.
+func (c *Client) FindAuditLogs(ctx context.Context, filter *db.AuditLogFilter) ([]*db.AuditLog, error) {
+	query := bson.M{}
+	if filter.Subject != "" {
+		query["subject"] = filter.Subject
+	}
+	if filter.ClientID != "" {
+		encodedClientID, err := encodeID(filter.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		query["client_id"] = encodedClientID
+	}
+	if filter.Method != "" {
+		query["method"] = filter.Method
+	}
+	if filter.DocKey != "" {
+		query["doc_key"] = filter.DocKey
+	}
+	if filter.DeniedOnly {
+		query["denied"] = true
+	}
+
+	createdAt := bson.M{}
+	if !filter.Since.IsZero() {
+		createdAt["$gte"] = filter.Since
+	}
+	if !filter.Until.IsZero() {
+		createdAt["$lt"] = filter.Until
+	}
+	if len(createdAt) > 0 {
+		query["created_at"] = createdAt
+	}
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "created_at", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+	if filter.Limit > 0 {
+		opts = opts.SetLimit(filter.Limit)
+	}
+
+	cursor, err := c.collection(ColAuditLogs).Find(ctx, query, opts)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	var logs []*db.AuditLog
+	if err := cursor.All(ctx, &logs); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return logs, nil
+}
+
 func (c *Client) findTicketByServerSeq(
 	ctx context.Context,
 	docID db.ID,
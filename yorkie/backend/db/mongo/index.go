@@ -47,6 +47,22 @@ var (
 			{Key: "server_seq", Value: bsonx.Int32(1)},
 		},
 		Options: options.Index().SetUnique(true),
+	}, {
+		Keys: bsonx.Doc{
+			{Key: "collection", Value: bsonx.Int32(1)},
+			{Key: "collection_seq", Value: bsonx.Int32(1)},
+		},
+	}, {
+		Keys: bsonx.Doc{
+			{Key: "collection", Value: bsonx.Int32(1)},
+			{Key: "stored_at", Value: bsonx.Int32(1)},
+		},
+	}}
+
+	ColCollectionSeqs = "collectionseqs"
+	idxCollectionSeqs = []mongo.IndexModel{{
+		Keys:    bsonx.Doc{{Key: "collection", Value: bsonx.Int32(1)}},
+		Options: options.Index().SetUnique(true),
 	}}
 
 	ColSnapshots = "snapshots"
@@ -58,6 +74,24 @@ var (
 		Options: options.Index().SetUnique(true),
 	}}
 
+	ColAuditLogs = "auditlogs"
+	idxAuditLogs = []mongo.IndexModel{{
+		Keys: bsonx.Doc{
+			{Key: "created_at", Value: bsonx.Int32(1)},
+			{Key: "_id", Value: bsonx.Int32(1)},
+		},
+	}, {
+		Keys: bsonx.Doc{
+			{Key: "doc_key", Value: bsonx.Int32(1)},
+			{Key: "created_at", Value: bsonx.Int32(1)},
+		},
+	}, {
+		Keys: bsonx.Doc{
+			{Key: "subject", Value: bsonx.Int32(1)},
+			{Key: "created_at", Value: bsonx.Int32(1)},
+		},
+	}}
+
 	ColSyncedSeqs = "syncedseqs"
 	idxSyncedSeqs = []mongo.IndexModel{{
 		Keys: bsonx.Doc{
@@ -98,6 +132,14 @@ func ensureIndexes(ctx context.Context, db *mongo.Database) error {
 		return err
 	}
 
+	if _, err := db.Collection(ColCollectionSeqs).Indexes().CreateMany(
+		ctx,
+		idxCollectionSeqs,
+	); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
 	if _, err := db.Collection(ColSnapshots).Indexes().CreateMany(
 		ctx,
 		idxSnapshots,
@@ -114,5 +156,13 @@ func ensureIndexes(ctx context.Context, db *mongo.Database) error {
 		return err
 	}
 
+	if _, err := db.Collection(ColAuditLogs).Indexes().CreateMany(
+		ctx,
+		idxAuditLogs,
+	); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo
+
+import (
+	"context"
+	gotime "time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// legacyChangeInfos is the filter of the changes stored without collection
+// seqs, by the agents of the previous versions.
+var legacyChangeInfos = bson.M{
+	"collection_seq": bson.M{"$exists": false},
+}
+
+// FindDocInfosOfLegacyChanges returns the documents that have the changes
+// stored without collection seqs.
+func (c *Client) FindDocInfosOfLegacyChanges(ctx context.Context) ([]*db.DocInfo, error) {
+	docIDs, err := c.collection(ColChanges).Distinct(ctx, "doc_id", legacyChangeInfos)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+	if len(docIDs) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := c.collection(ColDocuments).Find(ctx, bson.M{
+		"_id": bson.M{"$in": docIDs},
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	var infos []*db.DocInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// MigrateChangeInfos gives the next collection seqs to the changes of the
+// given document stored without them, in the order of their server seqs, so
+// that WatchChanges delivers them after the changes stored so far. It returns
+// the number of the migrated changes. The caller should hold the lock of the
+// changes of the collection of the document, as StoreChangeInfos.
+func (c *Client) MigrateChangeInfos(ctx context.Context, docInfo *db.DocInfo) (int, error) {
+	encodedDocID, err := encodeID(docInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := c.collection(ColChanges).Find(ctx, bson.M{
+		"$and": bson.A{
+			bson.M{"doc_id": encodedDocID},
+			legacyChangeInfos,
+		},
+	}, options.Find().
+		SetSort(bson.M{"server_seq": 1}).
+		SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	var infos []*db.ChangeInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+	if len(infos) == 0 {
+		return 0, nil
+	}
+
+	firstCollectionSeq, err := c.nextCollectionSeqs(ctx, docKey.Collection, len(infos))
+	if err != nil {
+		return 0, err
+	}
+	storedAt := gotime.Now()
+
+	var models []mongo.WriteModel
+	for i, info := range infos {
+		encodedID, err := encodeID(info.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{
+			"_id": encodedID,
+		}).SetUpdate(bson.M{
+			"$set": bson.M{
+				"doc_key":        docInfo.Key,
+				"collection":     docKey.Collection,
+				"collection_seq": firstCollectionSeq + uint64(i),
+				"stored_at":      storedAt,
+			},
+		}))
+	}
+
+	if _, err := c.collection(ColChanges).BulkWrite(
+		ctx,
+		models,
+		options.BulkWrite().SetOrdered(true),
+	); err != nil {
+		log.Logger.Error(err)
+		return 0, err
+	}
+
+	return len(infos), nil
+}
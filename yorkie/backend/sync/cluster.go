@@ -0,0 +1,33 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import "errors"
+
+// Below are the metadata keys of the requests between the agents of a cluster.
+const (
+	// AgentIDMetadataKey is the metadata key of the ID of the calling agent.
+	AgentIDMetadataKey = "x-yorkie-agent-id"
+
+	// ClusterSecretMetadataKey is the metadata key of the secret shared by the
+	// agents of the cluster.
+	ClusterSecretMetadataKey = "x-yorkie-cluster-secret"
+)
+
+// ErrNotClusterMember is returned when the caller of a cluster request is not
+// a member of the cluster.
+var ErrNotClusterMember = errors.New("not a member of the cluster")
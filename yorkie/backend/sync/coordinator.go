@@ -21,6 +21,7 @@ import (
 	"errors"
 	gotime "time"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -29,6 +30,18 @@ import (
 var (
 	// ErrEmptyDocKeys is returned when the given keys is empty.
 	ErrEmptyDocKeys = errors.New("empty doc keys")
+
+	// ErrNotSubscribed is returned when the given client does not subscribe
+	// to the given document.
+	ErrNotSubscribed = errors.New("document not subscribed")
+
+	// ErrPurgeFailed is returned when the auth cache of any member is not
+	// purged.
+	ErrPurgeFailed = errors.New("failed to purge the auth cache of some members")
+
+	// ErrPayloadTooLarge is returned when the payload of the given broadcast
+	// message exceeds the max size.
+	ErrPayloadTooLarge = errors.New("payload too large")
 )
 
 // AgentInfo represents the information of the Agent.
@@ -58,12 +71,30 @@ type Coordinator interface {
 		sub *Subscription,
 	) error
 
+	// UpdateSubscription adds and removes the given documents to and from the
+	// given subscription without closing it. It returns the peers of the
+	// added documents.
+	UpdateSubscription(
+		ctx context.Context,
+		sub *Subscription,
+		addedKeys []*key.Key,
+		removedKeys []*key.Key,
+	) (map[string][]types.Client, error)
+
 	// Publish publishes the given event.
 	Publish(ctx context.Context, publisherID *time.ActorID, event DocEvent)
 
 	// PublishToLocal publishes the given event.
 	PublishToLocal(ctx context.Context, publisherID *time.ActorID, event DocEvent)
 
+	// EnsureSubscribed ensures the given client subscribes to all the given
+	// documents in this cluster.
+	EnsureSubscribed(
+		ctx context.Context,
+		subscriberID *time.ActorID,
+		docKeys []*key.Key,
+	) error
+
 	// UpdateMetadata updates the metadata of the given client.
 	UpdateMetadata(
 		ctx context.Context,
@@ -71,6 +102,11 @@ type Coordinator interface {
 		keys []*key.Key,
 	) (*DocEvent, error)
 
+	// PurgeAuthCache purges the auth caches of the other members of this
+	// cluster with the given request, and returns the number of the purged
+	// decisions.
+	PurgeAuthCache(ctx context.Context, req *api.PurgeAuthCacheRequest) (int, error)
+
 	// Members returns the members of this cluster.
 	Members() map[string]*AgentInfo
 
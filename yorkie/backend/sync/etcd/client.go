@@ -49,6 +49,12 @@ type Client struct {
 	clusterClientMapMu *gosync.RWMutex
 	clusterClientMap   map[string]*clusterClientInfo
 
+	// clusterDialOption is the dial option to call the other agents.
+	clusterDialOption grpc.DialOption
+
+	// clusterSecret is the secret shared by the agents of the cluster.
+	clusterSecret []byte
+
 	ctx        context.Context
 	cancelFunc context.CancelFunc
 }
@@ -77,6 +83,18 @@ func newClient(conf *Config, agentInfo *sync.AgentInfo) *Client {
 func Dial(conf *Config, agentInfo *sync.AgentInfo) (*Client, error) {
 	c := newClient(conf, agentInfo)
 
+	clusterDialOption, err := newClusterDialOption(conf)
+	if err != nil {
+		return nil, err
+	}
+	c.clusterDialOption = clusterDialOption
+
+	clusterSecret, err := readClusterSecret(conf)
+	if err != nil {
+		return nil, err
+	}
+	c.clusterSecret = clusterSecret
+
 	if err := c.Dial(); err != nil {
 		return nil, err
 	}
@@ -59,6 +59,24 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			config: &etcd.Config{
+				Endpoints:       []string{"localhost:2379"},
+				DialTimeout:     "5s",
+				LockLeaseTime:   "30s",
+				ClusterCertFile: "cert.pem",
+			},
+			expected: etcd.ErrInvalidClusterCertificate,
+		},
+		{
+			config: &etcd.Config{
+				Endpoints:         []string{"localhost:2379"},
+				DialTimeout:       "5s",
+				LockLeaseTime:     "30s",
+				ClusterSecretFile: "secret",
+			},
+			expected: etcd.ErrInvalidClusterCertificate,
+		},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(
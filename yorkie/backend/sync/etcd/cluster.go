@@ -0,0 +1,101 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+)
+
+// newClusterDialOption creates the dial option to call the other agents of
+// the cluster. The agents are called with TLS if the CA of the cluster is
+// given.
+func newClusterDialOption(conf *Config) (grpc.DialOption, error) {
+	if conf.ClusterCAFile == "" {
+		return grpc.WithInsecure(), nil
+	}
+
+	caCerts, err := ioutil.ReadFile(filepath.Clean(conf.ClusterCAFile))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidClusterCertificate)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCerts) {
+		return nil, fmt.Errorf("%s: no certificate: %w", conf.ClusterCAFile, ErrInvalidClusterCertificate)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+	if conf.ClusterCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.ClusterCertFile, conf.ClusterKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidClusterCertificate)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+// readClusterSecret reads the secret shared by the agents of the cluster. It
+// returns nil if the secret file is not given.
+func readClusterSecret(conf *Config) ([]byte, error) {
+	if conf.ClusterSecretFile == "" {
+		return nil, nil
+	}
+
+	secret, err := ioutil.ReadFile(filepath.Clean(conf.ClusterSecretFile))
+	if err != nil {
+		return nil, err
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("%s: empty cluster secret", conf.ClusterSecretFile)
+	}
+
+	return secret, nil
+}
+
+// ClusterSecret returns the secret shared by the agents of the cluster. It
+// returns nil if the secret is not configured.
+func (c *Client) ClusterSecret() []byte {
+	return c.clusterSecret
+}
+
+// withClusterMetadata returns a new context that carries the ID of this agent
+// and the cluster secret to the other agents.
+func (c *Client) withClusterMetadata(ctx context.Context) context.Context {
+	kv := []string{sync.AgentIDMetadataKey, c.agentInfo.ID}
+	if c.clusterSecret != nil {
+		kv = append(kv, sync.ClusterSecretMetadataKey, string(c.clusterSecret))
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
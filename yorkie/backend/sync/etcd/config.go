@@ -33,6 +33,14 @@ const (
 var (
 	//ErrEmptyEndpoints occurs when the endpoints in the config is empty.
 	ErrEmptyEndpoints = errors.New("length of etcd endpoints must be greater than 0")
+
+	// ErrInvalidClusterCertificate occurs when the certificate to call the
+	// other agents is not valid.
+	ErrInvalidClusterCertificate = errors.New("invalid cluster certificate")
+
+	// ErrClusterNotAuthenticated occurs when the agents of the cluster can not
+	// authenticate the requests of each other.
+	ErrClusterNotAuthenticated = errors.New("cluster requests are not authenticated")
 )
 
 // Config is the configuration for creating a Client instance.
@@ -43,6 +51,27 @@ type Config struct {
 	Password    string   `json:"Password"`
 
 	LockLeaseTime string `json:"LockLeaseTime"`
+
+	// ClusterCAFile is the path of the PEM file of the CA certificates shared
+	// by the agents of the cluster. If it is set, the agents are called with
+	// TLS and verified with it.
+	ClusterCAFile string `json:"ClusterCAFile"`
+
+	// ClusterCertFile and ClusterKeyFile are the paths of the PEM files of the
+	// certificate and the key presented to the other agents.
+	ClusterCertFile string `json:"ClusterCertFile"`
+	ClusterKeyFile  string `json:"ClusterKeyFile"`
+
+	// ClusterSecretFile is the path of the file of the secret shared by the
+	// agents of the cluster. The agents reject the cluster requests without
+	// it. It requires ClusterCAFile so that the secret is not sent in
+	// plaintext.
+	ClusterSecretFile string `json:"ClusterSecretFile"`
+
+	// ClusterInsecure is whether to accept the cluster requests authenticated
+	// neither by the cluster secret nor by the cluster certificates, only by
+	// the addresses of the members. It is only for trusted networks.
+	ClusterInsecure bool `json:"ClusterInsecure"`
 }
 
 // Validate validates this config.
@@ -67,6 +96,18 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if (len(c.ClusterCertFile) == 0) != (len(c.ClusterKeyFile) == 0) {
+		return ErrInvalidClusterCertificate
+	}
+
+	if c.ClusterSecretFile != "" && c.ClusterCAFile == "" {
+		return fmt.Errorf(
+			"invalid argument \"%s\" for \"--etcd-cluster-secret-file\" flag: cluster CA file required: %w",
+			c.ClusterSecretFile,
+			ErrInvalidClusterCertificate,
+		)
+	}
+
 	return nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
@@ -78,6 +79,37 @@ func (c *Client) Unsubscribe(
 	return c.removeSubscriptions(ctx, keys, sub)
 }
 
+// UpdateSubscription adds and removes the given keys to and from the given
+// subscription without closing it.
+func (c *Client) UpdateSubscription(
+	ctx context.Context,
+	sub *sync.Subscription,
+	addedKeys []*key.Key,
+	removedKeys []*key.Key,
+) (map[string][]types.Client, error) {
+	c.localPubSub.AddKeys(sub, addedKeys)
+	if err := c.putSubscriptions(ctx, addedKeys, sub); err != nil {
+		return nil, err
+	}
+
+	c.localPubSub.RemoveKeys(sub, removedKeys)
+	if err := c.removeSubscriptions(ctx, removedKeys, sub); err != nil {
+		return nil, err
+	}
+
+	peersMap := make(map[string][]types.Client)
+	for _, k := range addedKeys {
+		subs, err := c.pullSubscriptions(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+
+		peersMap[k.BSONKey()] = subs
+	}
+
+	return peersMap, nil
+}
+
 // Publish publishes the given event.
 func (c *Client) Publish(
 	ctx context.Context,
@@ -97,6 +129,40 @@ func (c *Client) PublishToLocal(
 	c.localPubSub.Publish(publisherID, event)
 }
 
+// EnsureSubscribed ensures the given client subscribes to all the given
+// documents in this cluster. The subscriptions of the other agents are read
+// from etcd only if the client does not subscribe to the document in this
+// agent.
+func (c *Client) EnsureSubscribed(
+	ctx context.Context,
+	subscriberID *time.ActorID,
+	docKeys []*key.Key,
+) error {
+	for _, docKey := range docKeys {
+		if c.localPubSub.IsSubscribed(subscriberID, docKey) {
+			continue
+		}
+
+		subscribers, err := c.pullSubscriptions(ctx, docKey)
+		if err != nil {
+			return err
+		}
+
+		subscribed := false
+		for _, subscriber := range subscribers {
+			if subscriber.ID.Compare(subscriberID) == 0 {
+				subscribed = true
+				break
+			}
+		}
+		if !subscribed {
+			return fmt.Errorf("%s: %w", docKey.BSONKey(), sync.ErrNotSubscribed)
+		}
+	}
+
+	return nil
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (c *Client) UpdateMetadata(
 	ctx context.Context,
@@ -140,6 +206,41 @@ func (c *Client) broadcastToMembers(ctx context.Context, event sync.DocEvent) {
 	}
 }
 
+// PurgeAuthCache purges the auth caches of the other members with the given
+// request. It tries all the members even if some of them fail, and returns
+// an error naming the failed members.
+func (c *Client) PurgeAuthCache(
+	ctx context.Context,
+	req *api.PurgeAuthCacheRequest,
+) (int, error) {
+	purged := 0
+	var failed []string
+	for _, member := range c.Members() {
+		if member.RPCAddr == c.agentInfo.RPCAddr {
+			continue
+		}
+
+		clientInfo, err := c.ensureClusterClient(member)
+		if err != nil {
+			failed = append(failed, member.ID)
+			continue
+		}
+
+		resp, err := clientInfo.client.PurgeAuthCache(c.withClusterMetadata(ctx), req)
+		if err != nil {
+			log.Logger.Error(err)
+			failed = append(failed, member.ID)
+			continue
+		}
+		purged += int(resp.PurgedCount)
+	}
+
+	if len(failed) > 0 {
+		return purged, fmt.Errorf("%s: %w", strings.Join(failed, ", "), sync.ErrPurgeFailed)
+	}
+	return purged, nil
+}
+
 // ensureClusterClient return the cluster client from the cache or creates it.
 func (c *Client) ensureClusterClient(
 	member *sync.AgentInfo,
@@ -151,7 +252,7 @@ func (c *Client) ensureClusterClient(
 	// be removed from clusterClientMap.
 
 	if _, ok := c.clusterClientMap[member.ID]; !ok {
-		conn, err := grpc.Dial(member.RPCAddr, grpc.WithInsecure())
+		conn, err := grpc.Dial(member.RPCAddr, c.clusterDialOption)
 		if err != nil {
 			log.Logger.Error(err)
 			return nil, err
@@ -193,7 +294,7 @@ func (c *Client) publishToMember(
 		return err
 	}
 
-	if _, err := clientInfo.client.BroadcastEvent(ctx, &api.BroadcastEventRequest{
+	if _, err := clientInfo.client.BroadcastEvent(c.withClusterMetadata(ctx), &api.BroadcastEventRequest{
 		PublisherId: publisherID.Bytes(),
 		Event:       docEvent,
 	}); err != nil {
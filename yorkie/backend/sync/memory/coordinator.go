@@ -18,9 +18,11 @@ package memory
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/moby/locker"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -80,6 +82,20 @@ func (m *Coordinator) Unsubscribe(
 	return nil
 }
 
+// UpdateSubscription adds and removes the given documents to and from the
+// given subscription without closing it.
+func (m *Coordinator) UpdateSubscription(
+	_ context.Context,
+	sub *sync.Subscription,
+	addedKeys []*key.Key,
+	removedKeys []*key.Key,
+) (map[string][]types.Client, error) {
+	m.pubSub.AddKeys(sub, addedKeys)
+	m.pubSub.RemoveKeys(sub, removedKeys)
+
+	return m.pubSub.BuildPeersMap(addedKeys), nil
+}
+
 // Publish publishes the given event.
 func (m *Coordinator) Publish(
 	_ context.Context,
@@ -98,6 +114,22 @@ func (m *Coordinator) PublishToLocal(
 	m.pubSub.Publish(publisherID, event)
 }
 
+// EnsureSubscribed ensures the given client subscribes to all the given
+// documents.
+func (m *Coordinator) EnsureSubscribed(
+	_ context.Context,
+	subscriberID *time.ActorID,
+	docKeys []*key.Key,
+) error {
+	for _, docKey := range docKeys {
+		if !m.pubSub.IsSubscribed(subscriberID, docKey) {
+			return fmt.Errorf("%s: %w", docKey.BSONKey(), sync.ErrNotSubscribed)
+		}
+	}
+
+	return nil
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (m *Coordinator) UpdateMetadata(
 	_ context.Context,
@@ -113,6 +145,15 @@ func (m *Coordinator) UpdateMetadata(
 	}, nil
 }
 
+// PurgeAuthCache does nothing because this agent is the only member of the
+// cluster.
+func (m *Coordinator) PurgeAuthCache(
+	_ context.Context,
+	_ *api.PurgeAuthCacheRequest,
+) (int, error) {
+	return 0, nil
+}
+
 // Members returns the members of this cluster.
 func (m *Coordinator) Members() map[string]*sync.AgentInfo {
 	members := make(map[string]*sync.AgentInfo)
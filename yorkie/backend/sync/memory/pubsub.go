@@ -58,6 +58,11 @@ func (s *subscriptions) Delete(id string) {
 	delete(s.internalMap, id)
 }
 
+// Remove removes the subscription of the given id without closing it.
+func (s *subscriptions) Remove(id string) {
+	delete(s.internalMap, id)
+}
+
 // Len returns the length of these subscriptions.
 func (s *subscriptions) Len() int {
 	return len(s.internalMap)
@@ -120,14 +125,54 @@ func (m *PubSub) Subscribe(
 	return sub, nil
 }
 
+// AddKeys adds the given keys to the given subscription.
+func (m *PubSub) AddKeys(
+	sub *sync.Subscription,
+	keys []*key.Key,
+) {
+	m.subscriptionsMapMu.Lock()
+	defer m.subscriptionsMapMu.Unlock()
+
+	for _, docKey := range keys {
+		bsonKey := docKey.BSONKey()
+		if _, ok := m.subscriptionsMapByDocKey[bsonKey]; !ok {
+			m.subscriptionsMapByDocKey[bsonKey] = newSubscriptions()
+		}
+		m.subscriptionsMapByDocKey[bsonKey].Add(sub)
+	}
+}
+
+// RemoveKeys removes the given keys from the given subscription without
+// closing it.
+func (m *PubSub) RemoveKeys(
+	sub *sync.Subscription,
+	keys []*key.Key,
+) {
+	m.subscriptionsMapMu.Lock()
+	defer m.subscriptionsMapMu.Unlock()
+
+	for _, docKey := range keys {
+		k := docKey.BSONKey()
+		if subs, ok := m.subscriptionsMapByDocKey[k]; ok {
+			subs.Remove(sub.ID())
+
+			if subs.Len() == 0 {
+				delete(m.subscriptionsMapByDocKey, k)
+			}
+		}
+	}
+}
+
 // BuildPeersMap builds the peers map of the given keys.
 func (m *PubSub) BuildPeersMap(keys []*key.Key) map[string][]types.Client {
 	peersMap := make(map[string][]types.Client)
 	for _, docKey := range keys {
 		bsonKey := docKey.BSONKey()
 		var peers []types.Client
-		for _, sub := range m.subscriptionsMapByDocKey[bsonKey].Map() {
-			peers = append(peers, sub.Subscriber())
+		if subs, ok := m.subscriptionsMapByDocKey[bsonKey]; ok {
+			for _, sub := range subs.Map() {
+				peers = append(peers, sub.Subscriber())
+			}
 		}
 		peersMap[bsonKey] = peers
 	}
@@ -223,6 +268,26 @@ func (m *PubSub) Publish(
 	}
 }
 
+// IsSubscribed returns whether the given subscriber subscribes to the given
+// document.
+func (m *PubSub) IsSubscribed(subscriberID *time.ActorID, docKey *key.Key) bool {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	subs, ok := m.subscriptionsMapByDocKey[docKey.BSONKey()]
+	if !ok {
+		return false
+	}
+
+	for _, sub := range subs.Map() {
+		if sub.Subscriber().ID.Compare(subscriberID) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (m *PubSub) UpdateMetadata(
 	publisher *types.Client,
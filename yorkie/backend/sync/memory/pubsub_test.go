@@ -85,4 +85,55 @@ func TestPubSub(t *testing.T) {
 			assert.Len(t, subs[docKeys[0].BSONKey()], i+1)
 		}
 	})
+
+	t.Run("add and remove keys test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		keyA := &key.Key{Collection: helper.Collection, Document: t.Name() + "-a"}
+		keyB := &key.Key{Collection: helper.Collection, Document: t.Name() + "-b"}
+
+		sub, err := pubSub.Subscribe(actorA, []*key.Key{keyA})
+		assert.NoError(t, err)
+
+		pubSub.AddKeys(sub, []*key.Key{keyB})
+		peersMap := pubSub.BuildPeersMap([]*key.Key{keyA, keyB})
+		assert.Len(t, peersMap[keyA.BSONKey()], 1)
+		assert.Len(t, peersMap[keyB.BSONKey()], 1)
+
+		pubSub.RemoveKeys(sub, []*key.Key{keyA})
+		peersMap = pubSub.BuildPeersMap([]*key.Key{keyA, keyB})
+		assert.Len(t, peersMap[keyA.BSONKey()], 0)
+		assert.Len(t, peersMap[keyB.BSONKey()], 1)
+
+		// the subscription is still alive after removing some of its keys
+		event := sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			Publisher:    actorB,
+			DocumentKeys: []*key.Key{keyB},
+		}
+		var wg gosync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e := <-sub.Events()
+			assert.Equal(t, e, event)
+		}()
+		pubSub.Publish(actorB.ID, event)
+		wg.Wait()
+
+		pubSub.Unsubscribe([]*key.Key{keyB}, sub)
+	})
+
+	t.Run("is subscribed test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		docKey := &key.Key{Collection: helper.Collection, Document: t.Name()}
+		assert.False(t, pubSub.IsSubscribed(actorA.ID, docKey))
+
+		sub, err := pubSub.Subscribe(actorA, []*key.Key{docKey})
+		assert.NoError(t, err)
+		assert.True(t, pubSub.IsSubscribed(actorA.ID, docKey))
+		assert.False(t, pubSub.IsSubscribed(actorB.ID, docKey))
+
+		pubSub.Unsubscribe([]*key.Key{docKey}, sub)
+		assert.False(t, pubSub.IsSubscribed(actorA.ID, docKey))
+	})
 }
@@ -19,6 +19,7 @@ package sync
 import (
 	"github.com/rs/xid"
 
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/types"
 )
@@ -50,6 +51,13 @@ type DocEvent struct {
 	Type         types.DocEventType
 	Publisher    types.Client
 	DocumentKeys []*key.Key
+
+	// Payload is the transient message of BroadcastEvent.
+	Payload []byte
+
+	// ChangePack has the changes stored by DocumentsChangedEvent. The
+	// checkpoint of the pack has the server seq of the last change.
+	ChangePack *change.Pack
 }
 
 // Events returns the DocEvent channel of this subscription.
@@ -19,8 +19,10 @@ package clients
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 )
@@ -33,13 +35,15 @@ var (
 	ErrInvalidClientID = errors.New("invalid client id")
 )
 
-// Activate activates the given client.
+// Activate activates the given client. The client is bound to the subject
+// authenticated by the token of the request.
 func Activate(
 	ctx context.Context,
 	be *backend.Backend,
 	clientKey string,
 ) (*db.ClientInfo, error) {
-	return be.DB.ActivateClient(ctx, clientKey)
+	subject, _ := auth.SubjectFromCtx(ctx)
+	return be.DB.ActivateClient(ctx, clientKey, subject)
 }
 
 // Deactivate deactivates the given client.
@@ -48,9 +52,32 @@ func Deactivate(
 	be *backend.Backend,
 	clientID []byte,
 ) (*db.ClientInfo, error) {
+	if _, err := FindClient(ctx, be, clientID); err != nil {
+		return nil, err
+	}
+
 	return be.DB.DeactivateClient(ctx, db.IDFromBytes(clientID))
 }
 
+// FindClient finds the given client. The client should be able to be used by
+// the subject authenticated by the token of the request.
+func FindClient(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID []byte,
+) (*db.ClientInfo, error) {
+	clientInfo, err := be.DB.FindClientInfoByID(ctx, db.IDFromBytes(clientID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureSubject(ctx, be, clientInfo); err != nil {
+		return nil, err
+	}
+
+	return clientInfo, nil
+}
+
 // FindClientAndDocument finds the client and the document.
 func FindClientAndDocument(
 	ctx context.Context,
@@ -59,7 +86,7 @@ func FindClientAndDocument(
 	pack *change.Pack,
 	createDocIfNotExist bool,
 ) (*db.ClientInfo, *db.DocInfo, error) {
-	clientInfo, err := be.DB.FindClientInfoByID(ctx, db.IDFromBytes(clientID))
+	clientInfo, err := FindClient(ctx, be, clientID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -76,3 +103,32 @@ func FindClientAndDocument(
 
 	return clientInfo, docInfo, nil
 }
+
+// ensureSubject ensures the given client can be used by the subject of the
+// request. The client activated without a subject is bound to the subject of
+// the first authenticated request that uses it, so that it can not be used by
+// the other subjects. The requests whose access is not verified are not
+// checked.
+func ensureSubject(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+) error {
+	subject, ok := auth.SubjectFromCtx(ctx)
+	if !ok {
+		return nil
+	}
+
+	if clientInfo.Subject == "" && subject != "" {
+		if err := be.DB.BindClientSubject(ctx, clientInfo.ID, subject); err != nil {
+			return err
+		}
+		clientInfo.Subject = subject
+	}
+
+	if err := clientInfo.EnsureSubject(subject); err != nil {
+		return fmt.Errorf("%s: %w", clientInfo.ID, err)
+	}
+
+	return nil
+}
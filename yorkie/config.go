@@ -43,13 +43,24 @@ const (
 	DefaultMongoPingTimeout       = 5 * time.Second
 	DefaultMongoYorkieDatabase    = "yorkie-meta"
 
-	DefaultSnapshotThreshold = 500
-	DefaultSnapshotInterval  = 100
+	DefaultSnapshotThreshold   = 500
+	DefaultSnapshotInterval    = 100
+	DefaultSnapshotCompression = "none"
+	DefaultDocCacheSize        = 100
+
+	DefaultMaxBroadcastPayloadSize = 64 * 1024 // 64KiB
 
 	DefaultAuthWebhookMaxRetries      = 10
 	DefaultAuthWebhookMaxWaitInterval = 3000 * time.Millisecond
 	DefaultAuthWebhookCacheAuthTTL    = 10 * time.Second
 	DefaultAuthWebhookCacheUnauthTTL  = 10 * time.Second
+	DefaultAuthWebhookCacheSize       = 5000
+	DefaultAuthWebhookRequestTimeout  = 10 * time.Second
+
+	DefaultChangeHookMaxRetries      = 10
+	DefaultChangeHookMaxWaitInterval = 3000 * time.Millisecond
+	DefaultChangeHookRequestTimeout  = 10 * time.Second
+	DefaultChangeHookQueueSize       = 1000
 )
 
 // Config is the configuration for creating a Yorkie instance.
@@ -109,7 +120,20 @@ func (c *Config) Validate() error {
 	}
 
 	if c.ETCD != nil {
-		return c.ETCD.Validate()
+		if err := c.ETCD.Validate(); err != nil {
+			return err
+		}
+
+		// NOTE: The cluster certificates are verified only by the agents with
+		//       the client CA file.
+		certVerified := c.ETCD.ClusterCertFile != "" && c.RPC.ClientCAFile != ""
+		if c.ETCD.ClusterSecretFile == "" && !certVerified && !c.ETCD.ClusterInsecure {
+			return fmt.Errorf(
+				"set --etcd-cluster-secret-file, --etcd-cluster-cert-file with --rpc-client-ca-file,"+
+					" or --etcd-cluster-insecure: %w",
+				etcd.ErrClusterNotAuthenticated,
+			)
+		}
 	}
 	return nil
 }
@@ -153,6 +177,10 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.SnapshotInterval = DefaultSnapshotInterval
 	}
 
+	if c.Backend.SnapshotCompression == "" {
+		c.Backend.SnapshotCompression = DefaultSnapshotCompression
+	}
+
 	if c.Backend.AuthWebhookMaxRetries == 0 {
 		c.Backend.AuthWebhookMaxRetries = DefaultAuthWebhookMaxRetries
 	}
@@ -169,6 +197,34 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.AuthWebhookCacheUnauthTTL = DefaultAuthWebhookCacheUnauthTTL.String()
 	}
 
+	if c.Backend.MaxBroadcastPayloadSize == 0 {
+		c.Backend.MaxBroadcastPayloadSize = DefaultMaxBroadcastPayloadSize
+	}
+
+	if c.Backend.AuthWebhookCacheSize == 0 {
+		c.Backend.AuthWebhookCacheSize = DefaultAuthWebhookCacheSize
+	}
+
+	if c.Backend.AuthWebhookRequestTimeout == "" {
+		c.Backend.AuthWebhookRequestTimeout = DefaultAuthWebhookRequestTimeout.String()
+	}
+
+	if c.Backend.ChangeHookMaxRetries == 0 {
+		c.Backend.ChangeHookMaxRetries = DefaultChangeHookMaxRetries
+	}
+
+	if c.Backend.ChangeHookMaxWaitInterval == "" {
+		c.Backend.ChangeHookMaxWaitInterval = DefaultChangeHookMaxWaitInterval.String()
+	}
+
+	if c.Backend.ChangeHookRequestTimeout == "" {
+		c.Backend.ChangeHookRequestTimeout = DefaultChangeHookRequestTimeout.String()
+	}
+
+	if c.Backend.ChangeHookQueueSize == 0 {
+		c.Backend.ChangeHookQueueSize = DefaultChangeHookQueueSize
+	}
+
 	if c.ETCD != nil {
 		if c.ETCD.DialTimeout == "" {
 			c.ETCD.DialTimeout = etcd.DefaultDialTimeout.String()
@@ -189,8 +245,12 @@ func newConfig(port int, profilingPort int, dbName string) *Config {
 			Port: profilingPort,
 		},
 		Backend: &backend.Config{
-			SnapshotThreshold: DefaultSnapshotThreshold,
-			SnapshotInterval:  DefaultSnapshotInterval,
+			SnapshotThreshold:       DefaultSnapshotThreshold,
+			SnapshotInterval:        DefaultSnapshotInterval,
+			SnapshotCompression:     DefaultSnapshotCompression,
+			DocCacheSize:            DefaultDocCacheSize,
+			MaxBroadcastPayloadSize: DefaultMaxBroadcastPayloadSize,
+			AuthWebhookCacheSize:    DefaultAuthWebhookCacheSize,
 		},
 		Mongo: &mongo.Config{
 			ConnectionURI:     DefaultMongoConnectionURI,
@@ -48,6 +48,7 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, pingTimeout, yorkie.DefaultMongoPingTimeout)
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(yorkie.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(yorkie.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.SnapshotCompression, yorkie.DefaultSnapshotCompression)
 		assert.Equal(t, conf.Backend.AuthWebhookURL, "")
 
 		assert.Nil(t, conf.ETCD)
@@ -73,6 +74,7 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, pingTimeout, yorkie.DefaultMongoPingTimeout)
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(yorkie.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(yorkie.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.SnapshotCompression, yorkie.DefaultSnapshotCompression)
 		assert.Equal(t, conf.Backend.AuthWebhookURL, "")
 		assert.Equal(t, conf.Backend.AuthWebhookMaxRetries, uint64(yorkie.DefaultAuthWebhookMaxRetries))
 
@@ -88,6 +90,22 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, authWebhookCacheUnauthTTL, yorkie.DefaultAuthWebhookCacheUnauthTTL)
 
+		assert.Equal(t, conf.Backend.MaxBroadcastPayloadSize, uint64(yorkie.DefaultMaxBroadcastPayloadSize))
+		assert.Equal(t, conf.Backend.AuthWebhookCacheSize, uint64(yorkie.DefaultAuthWebhookCacheSize))
+
+		authWebhookRequestTimeout, err := time.ParseDuration(conf.Backend.AuthWebhookRequestTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, authWebhookRequestTimeout, yorkie.DefaultAuthWebhookRequestTimeout)
+
+		assert.Equal(t, conf.Backend.ChangeHookMaxRetries, uint64(yorkie.DefaultChangeHookMaxRetries))
+		changeHookMaxWaitInterval, err := time.ParseDuration(conf.Backend.ChangeHookMaxWaitInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, changeHookMaxWaitInterval, yorkie.DefaultChangeHookMaxWaitInterval)
+
+		changeHookRequestTimeout, err := time.ParseDuration(conf.Backend.ChangeHookRequestTimeout)
+		assert.NoError(t, err)
+		assert.Equal(t, changeHookRequestTimeout, yorkie.DefaultChangeHookRequestTimeout)
+
 		assert.NotNil(t, conf.ETCD)
 		etcdDialTimeout, err := time.ParseDuration(conf.ETCD.DialTimeout)
 		assert.NoError(t, err)
@@ -98,3 +116,23 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, lockLeaseTime, etcd.DefaultLockLeaseTime)
 	})
 }
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("cluster authentication test", func(t *testing.T) {
+		conf, err := yorkie.NewConfigFromFile("config.sample.yml")
+		assert.NoError(t, err)
+		conf.ETCD.Endpoints = []string{"localhost:2379"}
+		assert.ErrorIs(t, conf.Validate(), etcd.ErrClusterNotAuthenticated)
+
+		// NOTE: The cluster certificates are not verified without the client
+		//       CA file of the RPC server.
+		conf.ETCD.ClusterCertFile = "cluster.crt"
+		conf.ETCD.ClusterKeyFile = "cluster.key"
+		assert.ErrorIs(t, conf.Validate(), etcd.ErrClusterNotAuthenticated)
+
+		conf.ETCD.ClusterCertFile = ""
+		conf.ETCD.ClusterKeyFile = ""
+		conf.ETCD.ClusterInsecure = true
+		assert.NoError(t, conf.Validate())
+	})
+}
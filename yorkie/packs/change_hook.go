@@ -0,0 +1,173 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"bytes"
+	"context"
+	gojson "encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/webhook"
+)
+
+// sendChangeHook calls the change hook of the collection of the given
+// document with the stored changes. The request is enqueued to the queue of
+// the collection, which sends the requests one by one in the order of the
+// changes and retries each with exponential backoff until the backend is
+// closed. It is called while the document is locked, so the requests of a
+// document are enqueued in the order of their server sequences. If the queue
+// is full, the request is dropped, and the change hook can detect the gap by
+// FromServerSeq of the next request.
+func sendChangeHook(
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docKey *key.Key,
+	changes []*change.Change,
+) {
+	url, ok := be.Config.ChangeHookURL(docKey.Collection)
+	if !ok || len(changes) == 0 {
+		return
+	}
+	queue, ok := be.ChangeHookQueues[docKey.Collection]
+	if !ok {
+		return
+	}
+
+	reqBody, err := newChangeHookRequestBody(be.Config, clientInfo, docKey, changes)
+	if err != nil {
+		log.Logger.Error(err)
+		return
+	}
+
+	if err := queue.Enqueue(func(ctx context.Context) {
+		if err := postChangeHook(ctx, be, url, reqBody); err != nil {
+			log.Logger.Errorf("change hook %s of %s: %s", url, docKey.BSONKey(), err.Error())
+		}
+	}); err != nil {
+		log.Logger.Errorf(
+			"change hook %s of %s: drop changes %d-%d: %s",
+			url,
+			docKey.BSONKey(),
+			changes[0].ServerSeq(),
+			changes[len(changes)-1].ServerSeq(),
+			err.Error(),
+		)
+	}
+}
+
+// postChangeHook posts the given body to the change hook of the given URL. It
+// signs the request if the secret of the change hooks is configured.
+func postChangeHook(
+	ctx context.Context,
+	be *backend.Backend,
+	url string,
+	reqBody []byte,
+) error {
+	return webhook.WithExponentialBackoff(
+		ctx,
+		be.Config.ChangeHookMaxRetries,
+		be.Config.ParseChangeHookMaxWaitInterval(),
+		func() (int, error) {
+			req, err := http.NewRequestWithContext(
+				ctx,
+				http.MethodPost,
+				url,
+				bytes.NewBuffer(reqBody),
+			)
+			if err != nil {
+				return 0, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if be.ChangeHookSecret != nil {
+				webhook.SignRequest(req, be.ChangeHookSecret, reqBody, time.Now())
+			}
+
+			resp, err := be.ChangeHookClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+
+			defer func() {
+				if err := resp.Body.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			if resp.StatusCode < http.StatusOK || http.StatusMultipleChoices <= resp.StatusCode {
+				return resp.StatusCode, webhook.ErrUnexpectedStatusCode
+			}
+
+			return resp.StatusCode, nil
+		},
+	)
+}
+
+// newChangeHookRequestBody creates the body of the change hook request for
+// the given changes.
+func newChangeHookRequestBody(
+	conf *backend.Config,
+	clientInfo *db.ClientInfo,
+	docKey *key.Key,
+	changes []*change.Change,
+) ([]byte, error) {
+	req := types.ChangeHookRequest{
+		DocumentKey:   docKey.BSONKey(),
+		Actor:         clientInfo.ID.String(),
+		FromServerSeq: changes[0].ServerSeq(),
+		ToServerSeq:   changes[len(changes)-1].ServerSeq(),
+	}
+
+	if conf.ChangeHookIncludeOperations {
+		marshaler := jsonpb.Marshaler{}
+		for _, c := range changes {
+			pbOps, err := converter.ToOperations(c.Operations())
+			if err != nil {
+				return nil, err
+			}
+
+			var ops []gojson.RawMessage
+			for _, pbOp := range pbOps {
+				op, err := marshaler.MarshalToString(pbOp)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, gojson.RawMessage(op))
+			}
+
+			req.Changes = append(req.Changes, types.ChangeHookChange{
+				ServerSeq:  c.ServerSeq(),
+				ClientSeq:  c.ClientSeq(),
+				Lamport:    c.ID().Lamport(),
+				Message:    c.Message(),
+				Operations: ops,
+			})
+		}
+	}
+
+	return gojson.Marshal(req)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// storeChangeInfos stores the given changes of the given document while
+// holding the lock of the changes of its collection. The changes of the
+// collection are given collection seqs and stored one by one under the lock,
+// so a change is visible only after all the changes with smaller seqs, and
+// WatchChanges can resume from a cursor without skipping changes.
+func storeChangeInfos(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) error {
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := lockChanges(ctx, be, docKey.Collection)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return be.DB.StoreChangeInfos(ctx, docInfo, initialServerSeq, changes)
+}
+
+// FindChangeCursorByTime returns the cursor right before the first change of
+// the given collection stored at or after the given time. The time is by the
+// clocks of the agents, so the position is approximate, and the stream should
+// be resumed from cursors instead.
+func FindChangeCursorByTime(
+	ctx context.Context,
+	be *backend.Backend,
+	collection string,
+	storedAt gotime.Time,
+) (db.ChangeCursor, error) {
+	unlock, err := lockChanges(ctx, be, collection)
+	if err != nil {
+		return db.ChangeCursor{}, err
+	}
+	defer unlock()
+
+	return be.DB.FindChangeCursorByTime(ctx, collection, storedAt)
+}
+
+// lockChanges locks the changes of the given collection, and returns the
+// function to unlock them.
+func lockChanges(ctx context.Context, be *backend.Backend, collection string) (func(), error) {
+	locker, err := be.Coordinator.NewLocker(ctx, NewChangesKey(collection))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := locker.Unlock(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}, nil
+}
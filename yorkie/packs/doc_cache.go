@@ -0,0 +1,262 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	gosync "sync"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// cachedDocument is a document materialized from the database and kept in the
+// document cache of the backend. The document is caught up with the stored
+// changes in place, so it is guarded by the mutex.
+type cachedDocument struct {
+	mu  gosync.Mutex
+	doc *document.InternalDocument
+
+	// pending is whether the document has the pushed changes validated on it
+	// but not stored yet. The pending document is not used by the others
+	// until the changes are stored.
+	pending bool
+}
+
+// withDocument calls the given function with the document of the given docInfo
+// at the given server seq. The cached document is caught up with the changes
+// stored after it, or the document is built from the last snapshot and cached
+// if it is not in the cache. The function must not modify the document nor
+// keep it after returning, and it is called while the cached document is
+// locked, so it should not block on I/O such as writes to the database.
+func withDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+	fn func(doc *document.InternalDocument) error,
+) error {
+	if be.DocCache == nil {
+		doc, err := buildDocumentFromLastSnapshot(ctx, be, docInfo, serverSeq)
+		if err != nil {
+			return err
+		}
+		return fn(doc)
+	}
+
+	cacheKey := docInfo.ID.String()
+	value, ok := be.DocCache.Get(cacheKey)
+	if ok {
+		cached := value.(*cachedDocument)
+		cached.mu.Lock()
+		defer cached.mu.Unlock()
+
+		// NOTE: The cached document can not be rolled back, so a document
+		//       ahead of the given server seq is built again without the cache.
+		if cached.doc != nil && !cached.pending && cached.doc.Checkpoint().ServerSeq <= serverSeq {
+			if err := applyChanges(ctx, be, docInfo.ID, cached.doc, serverSeq); err != nil {
+				// NOTE: The changes can be applied partially, so the cached
+				//       document is dropped.
+				cached.doc = nil
+				be.DocCache.Remove(cacheKey)
+				return err
+			}
+
+			be.Metrics.AddDocCacheHit()
+			if err := fn(cached.doc); err != nil {
+				return err
+			}
+
+			// NOTE: Get does not extend the TTL, so the document in use is
+			//       added again to keep it in the cache.
+			be.DocCache.Add(cacheKey, cached, backend.DocCacheTTL)
+			return nil
+		}
+	}
+
+	be.Metrics.AddDocCacheMiss()
+	doc, err := buildDocumentFromLastSnapshot(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(doc); err != nil {
+		return err
+	}
+
+	if !ok {
+		be.DocCache.Add(cacheKey, &cachedDocument{doc: doc}, backend.DocCacheTTL)
+	}
+	return nil
+}
+
+// withPendingDocument calls the given function with the document of the given
+// docInfo at its server seq to apply the pushed changes to it. The function
+// modifies the cached document in place without copying it, and the document
+// is marked as pending until the changes are stored by updateCachedDocument. If
+// the function fails, the document is dropped from the cache because the
+// changes can be applied partially. If the document cache is disabled, the
+// document is built from the last snapshot on every call.
+func withPendingDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	fn func(doc *document.InternalDocument) error,
+) error {
+	if be.DocCache == nil {
+		doc, err := buildDocumentFromLastSnapshot(ctx, be, docInfo, docInfo.ServerSeq)
+		if err != nil {
+			return err
+		}
+		return fn(doc)
+	}
+
+	cacheKey := docInfo.ID.String()
+	cached := &cachedDocument{}
+	if value, ok := be.DocCache.Get(cacheKey); ok {
+		cached = value.(*cachedDocument)
+	}
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	// NOTE: A pending document left by a push that failed before storing its
+	//       changes has the changes that are not stored, so it is built again.
+	if cached.doc != nil && !cached.pending && cached.doc.Checkpoint().ServerSeq <= docInfo.ServerSeq {
+		if err := applyChanges(ctx, be, docInfo.ID, cached.doc, docInfo.ServerSeq); err != nil {
+			cached.doc = nil
+			be.DocCache.Remove(cacheKey)
+			return err
+		}
+		be.Metrics.AddDocCacheHit()
+	} else {
+		be.Metrics.AddDocCacheMiss()
+		doc, err := buildDocumentFromLastSnapshot(ctx, be, docInfo, docInfo.ServerSeq)
+		if err != nil {
+			cached.doc = nil
+			be.DocCache.Remove(cacheKey)
+			return err
+		}
+		cached.doc = doc
+		cached.pending = false
+	}
+
+	if err := fn(cached.doc); err != nil {
+		cached.doc = nil
+		be.DocCache.Remove(cacheKey)
+		return err
+	}
+
+	cached.pending = true
+	be.DocCache.Add(cacheKey, cached, backend.DocCacheTTL)
+	return nil
+}
+
+// discardPendingDocument drops the cached document of the given docInfo if it
+// is pending, when the pushed changes applied to it are not stored.
+func discardPendingDocument(be *backend.Backend, docInfo *db.DocInfo) {
+	if be.DocCache == nil {
+		return
+	}
+
+	cacheKey := docInfo.ID.String()
+	value, ok := be.DocCache.Get(cacheKey)
+	if !ok {
+		return
+	}
+
+	cached := value.(*cachedDocument)
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	if cached.pending {
+		cached.doc = nil
+		cached.pending = false
+		be.DocCache.Remove(cacheKey)
+	}
+}
+
+// updateCachedDocument applies the given changes, stored right after the given
+// server seq, to the cached document of the given docInfo. If the document is
+// pending with the changes, only its checkpoint is moved to the stored changes.
+// If the cached document is not at the server seq, it is caught up later when
+// it is used.
+func updateCachedDocument(
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	initialServerSeq uint64,
+	changes []*change.Change,
+) {
+	if be.DocCache == nil || len(changes) == 0 {
+		return
+	}
+
+	cacheKey := docInfo.ID.String()
+	value, ok := be.DocCache.Get(cacheKey)
+	if !ok {
+		return
+	}
+
+	cached := value.(*cachedDocument)
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	if cached.doc == nil || cached.doc.Checkpoint().ServerSeq != initialServerSeq {
+		if cached.pending {
+			cached.doc = nil
+			cached.pending = false
+			be.DocCache.Remove(cacheKey)
+		}
+		return
+	}
+
+	// NOTE: The pending document already has the changes applied while they
+	//       were validated.
+	var applied []*change.Change
+	if !cached.pending {
+		applied = changes
+	}
+	cached.pending = false
+
+	if err := cached.doc.ApplyChangePack(change.NewPack(
+		cached.doc.Key(),
+		checkpoint.Initial.NextServerSeq(changes[len(changes)-1].ServerSeq()),
+		applied,
+		nil,
+	)); err != nil {
+		cached.doc = nil
+		be.DocCache.Remove(cacheKey)
+	}
+}
+
+// buildDocumentFromLastSnapshot creates a document of the given docInfo at the
+// given server seq from the last snapshot and the changes after it.
+func buildDocumentFromLastSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+) (*document.InternalDocument, error) {
+	snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildDocument(ctx, be, docInfo, snapshotInfo, serverSeq)
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// Below are the names of the limits, used as the label of the metrics.
+const (
+	limitOperationsPerChange        = "operations_per_change"
+	limitChangesPerPack             = "changes_per_pack"
+	limitDocumentSize               = "document_size"
+	limitTextLength                 = "text_length"
+	limitArrayLength                = "array_length"
+	limitAttachedClientsPerDocument = "attached_clients_per_document"
+)
+
+// ErrLimitExceeded is returned when the request exceeds the limits of the
+// backend.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// CheckAttachLimit checks whether one more client can attach the given
+// document. It should be called under the lock of the document until the
+// client is attached, so that concurrent attachments do not exceed the limit.
+func CheckAttachLimit(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+) error {
+	limit := be.Config.MaxAttachedClientsPerDocument
+	if limit == 0 {
+		return nil
+	}
+
+	attached, err := clientInfo.IsAttached(docInfo.ID)
+	if err == nil && attached {
+		return nil
+	}
+
+	count, err := be.DB.FindAttachedClientCount(ctx, docInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	if uint64(count) >= limit {
+		return limitExceeded(be, limitAttachedClientsPerDocument, uint64(count)+1, limit)
+	}
+
+	return nil
+}
+
+// checkPackLimits checks the number of changes and operations of the given
+// pack.
+func checkPackLimits(be *backend.Backend, pack *change.Pack) error {
+	if limit := be.Config.MaxChangesPerPack; limit > 0 &&
+		uint64(pack.ChangesLen()) > limit {
+		return limitExceeded(be, limitChangesPerPack, uint64(pack.ChangesLen()), limit)
+	}
+
+	if limit := be.Config.MaxOperationsPerChange; limit > 0 {
+		for _, c := range pack.Changes {
+			if uint64(len(c.Operations())) > limit {
+				return limitExceeded(be, limitOperationsPerChange, uint64(len(c.Operations())), limit)
+			}
+		}
+	}
+
+	return nil
+}
+
+// documentUsage is the usage of the limits by a document. Only the usages of
+// the configured limits are measured.
+type documentUsage struct {
+	// size is the size of the document in bytes, measured by its snapshot.
+	size uint64
+
+	// lengths are the lengths of the texts and arrays in the document by the
+	// keys of their creation tickets.
+	lengths map[string]elementLength
+}
+
+// elementLength is the length of a text or an array with the name of its
+// limit.
+type elementLength struct {
+	limit  string
+	length uint64
+}
+
+// measureDocument measures the usage of the limits by the given document.
+func measureDocument(be *backend.Backend, doc *document.InternalDocument) (*documentUsage, error) {
+	usage := &documentUsage{lengths: make(map[string]elementLength)}
+
+	if be.Config.MaxDocumentSize > 0 {
+		snapshot, err := converter.ObjectToBytes(doc.RootObject())
+		if err != nil {
+			return nil, err
+		}
+		usage.size = uint64(len(snapshot))
+	}
+
+	if be.Config.MaxTextLength > 0 || be.Config.MaxArrayLength > 0 {
+		measureElement(usage, doc.RootObject())
+	}
+
+	return usage, nil
+}
+
+// measureElement measures the lengths of the texts and arrays in the given
+// element recursively.
+func measureElement(usage *documentUsage, elem json.Element) {
+	switch elem := elem.(type) {
+	case *json.Object:
+		for _, member := range elem.Members() {
+			measureElement(usage, member)
+		}
+	case *json.Array:
+		elems := elem.Elements()
+		usage.lengths[elem.CreatedAt().Key()] = elementLength{
+			limit:  limitArrayLength,
+			length: uint64(len(elems)),
+		}
+		for _, e := range elems {
+			measureElement(usage, e)
+		}
+	case *json.Text:
+		usage.lengths[elem.CreatedAt().Key()] = elementLength{
+			limit:  limitTextLength,
+			length: textLength(elem.Nodes()),
+		}
+	case *json.RichText:
+		usage.lengths[elem.CreatedAt().Key()] = elementLength{
+			limit:  limitTextLength,
+			length: textLength(elem.Nodes()),
+		}
+	}
+}
+
+// textLength returns the length of the text consisting of the given nodes.
+func textLength(nodes []*json.RGATreeSplitNode) uint64 {
+	length := 0
+	for _, node := range nodes {
+		length += node.Len()
+	}
+	return uint64(length)
+}
+
+// checkDocumentLimits checks the usage of the limits by a document after
+// changes against the usage before them. Only the changes that make the
+// document grow past the limits are rejected, so that a document already over
+// the limits, e.g. by lowered limits, can still shrink.
+func checkDocumentLimits(be *backend.Backend, before, after *documentUsage) error {
+	if limit := be.Config.MaxDocumentSize; limit > 0 &&
+		after.size > limit && after.size > before.size {
+		return limitExceeded(be, limitDocumentSize, after.size, limit)
+	}
+
+	for key, elem := range after.lengths {
+		limit := be.Config.MaxArrayLength
+		if elem.limit == limitTextLength {
+			limit = be.Config.MaxTextLength
+		}
+		if limit == 0 || elem.length <= limit {
+			continue
+		}
+
+		if prev, ok := before.lengths[key]; ok && elem.length <= prev.length {
+			continue
+		}
+		return limitExceeded(be, elem.limit, elem.length, limit)
+	}
+
+	return nil
+}
+
+// limitExceeded records that the given limit is exceeded and returns the error.
+func limitExceeded(be *backend.Backend, name string, given, limit uint64) error {
+	be.Metrics.AddLimitExceeded(name)
+
+	return fmt.Errorf("%s(given %d, limit %d): %w", name, given, limit, ErrLimitExceeded)
+}
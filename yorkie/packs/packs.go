@@ -21,8 +21,10 @@ import (
 	"fmt"
 	gotime "time"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -41,22 +43,44 @@ func NewSnapshotKey(documentKey *key.Key) sync.Key {
 	return sync.NewKey(fmt.Sprintf("snapshot-%s", documentKey.BSONKey()))
 }
 
+// NewChangesKey creates a new sync.Key of the changes of the given collection.
+func NewChangesKey(collection string) sync.Key {
+	return sync.NewKey(fmt.Sprintf("changes-%s", collection))
+}
+
 // PushPull stores the given changes and returns accumulated changes of the
-// given document.
+// given document. If pushOnly is true, the changes are stored but the
+// accumulated changes are not returned. The snapshot of the returned pack is
+// encoded with one of the given snapshot compressions accepted by the client.
 func PushPull(
 	ctx context.Context,
 	be *backend.Backend,
 	clientInfo *db.ClientInfo,
 	docInfo *db.DocInfo,
 	reqPack *change.Pack,
+	pushOnly bool,
+	snapshotCompressions []api.SnapshotCompression,
 ) (*ServerPack, error) {
 	start := gotime.Now()
 	defer func() {
 		be.Metrics.ObservePushPullResponseSeconds(gotime.Since(start).Seconds())
 	}()
 
-	// TODO: Changes may be reordered or missing during communication on the network.
-	// We should check the change.pack with checkpoint to make sure the changes are in the correct order.
+	// 00. validate the pack so that reordered, missing or malformed changes
+	// are not stored.
+	if err := validatePack(ctx, be, clientInfo, docInfo, reqPack); err != nil {
+		return nil, err
+	}
+
+	// NOTE: The pushed changes are applied to the cached document while they
+	//       are validated, so the document is dropped if they are not stored.
+	stored := false
+	defer func() {
+		if !stored {
+			discardPendingDocument(be, docInfo)
+		}
+	}()
+
 	initialServerSeq := docInfo.ServerSeq
 
 	// 01. push changes.
@@ -68,7 +92,21 @@ func PushPull(
 	be.Metrics.AddPushPullReceivedOperations(reqPack.OperationsLen())
 
 	// 02. pull change pack.
-	respPack, err := pullPack(ctx, be, clientInfo, docInfo, reqPack, pushedCP, initialServerSeq)
+	var respPack *ServerPack
+	if pushOnly {
+		respPack, err = pushOnlyPack(clientInfo, docInfo, reqPack, pushedCP)
+	} else {
+		respPack, err = pullPack(
+			ctx,
+			be,
+			clientInfo,
+			docInfo,
+			reqPack,
+			pushedCP,
+			initialServerSeq,
+			snapshotCompressions,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -82,18 +120,22 @@ func PushPull(
 
 	// 03. store pushed changes, document info and checkpoint of the client to DB.
 	if len(pushedChanges) > 0 {
-		if err := be.DB.StoreChangeInfos(ctx, docInfo, initialServerSeq, pushedChanges); err != nil {
+		if err := storeChangeInfos(ctx, be, docInfo, initialServerSeq, pushedChanges); err != nil {
 			return nil, err
 		}
+		updateCachedDocument(be, docInfo, initialServerSeq, pushedChanges)
+
+		sendChangeHook(be, clientInfo, reqPack.DocumentKey, pushedChanges)
 	}
+	stored = true
 
 	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
 		return nil, err
 	}
 
 	// 04. update and find min synced ticket for garbage collection.
-	// NOTE Since the client could not receive the PushPull response,
-	//      the requested seq(reqPack) is stored instead of the response seq(resPack).
+	// NOTE: Since the client could not receive the PushPull response,
+	//       the requested seq(reqPack) is stored instead of the response seq(resPack).
 	respPack.MinSyncedTicket, err = be.DB.UpdateAndFindMinSyncedTicket(
 		ctx,
 		clientInfo,
@@ -106,6 +148,18 @@ func PushPull(
 
 	// 05. publish document change event then store snapshot asynchronously.
 	if reqPack.HasChanges() {
+		// NOTE: The stored changes are delivered with the event so that
+		//       watchers can apply them without PushPull.
+		var changedPack *change.Pack
+		if len(pushedChanges) > 0 {
+			changedPack = change.NewPack(
+				reqPack.DocumentKey,
+				checkpoint.Initial.NextServerSeq(docInfo.ServerSeq),
+				pushedChanges,
+				nil,
+			)
+		}
+
 		be.AttachGoroutine(func() {
 			publisherID, err := time.ActorIDFromHex(clientInfo.ID.String())
 			if err != nil {
@@ -114,6 +168,17 @@ func PushPull(
 			}
 
 			ctx := context.Background()
+			be.Coordinator.Publish(
+				ctx,
+				publisherID,
+				sync.DocEvent{
+					Type:         types.DocumentsChangedEvent,
+					Publisher:    types.Client{ID: publisherID},
+					DocumentKeys: []*key.Key{reqPack.DocumentKey},
+					ChangePack:   changedPack,
+				},
+			)
+
 			locker, err := be.Coordinator.NewLocker(
 				ctx,
 				NewSnapshotKey(reqPack.DocumentKey),
@@ -135,16 +200,6 @@ func PushPull(
 				}
 			}()
 
-			be.Coordinator.Publish(
-				ctx,
-				publisherID,
-				sync.DocEvent{
-					Type:         types.DocumentsChangedEvent,
-					Publisher:    types.Client{ID: publisherID},
-					DocumentKeys: []*key.Key{reqPack.DocumentKey},
-				},
-			)
-
 			start := gotime.Now()
 			if err := storeSnapshot(
 				ctx,
@@ -21,9 +21,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/internal/log"
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
@@ -44,6 +43,7 @@ func pullPack(
 	requestPack *change.Pack,
 	pushedCP *checkpoint.Checkpoint,
 	initialServerSeq uint64,
+	snapshotCompressions []api.SnapshotCompression,
 ) (*ServerPack, error) {
 	docKey, err := docInfo.GetKey()
 	if err != nil {
@@ -72,9 +72,46 @@ func pullPack(
 		return nil, err
 	}
 
+	snapshot, err = encodeSnapshot(be, snapshot, snapshotCompressions)
+	if err != nil {
+		return nil, err
+	}
+
 	return NewServerPack(docKey, pulledCP, nil, snapshot), err
 }
 
+// pushOnlyPack returns a pack that acknowledges the pushed changes without
+// pulling the changes of other clients. The server seq of the checkpoint is
+// kept as requested so that the skipped changes can be pulled later.
+func pushOnlyPack(
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	requestPack *change.Pack,
+	pushedCP *checkpoint.Checkpoint,
+) (*ServerPack, error) {
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The kept server seq is also stored as the synced seq of the
+	//       client, so the min synced ticket does not advance and the garbage
+	//       collection of the document is held back while the client is
+	//       push-only or paused. The client can't be excluded from the min
+	//       synced ticket, because the tombstones it has not pulled yet are
+	//       still needed to apply its later changes.
+	respCP := checkpoint.New(requestPack.Checkpoint.ServerSeq, pushedCP.ClientSeq)
+
+	log.Logger.Infof(
+		"PULL: '%s' skips pulling from '%s' in push-only mode, cp: %s",
+		clientInfo.ID,
+		docInfo.Key,
+		respCP.String(),
+	)
+
+	return NewServerPack(docKey, respCP, nil, nil), nil
+}
+
 func pullChangeInfos(
 	ctx context.Context,
 	be *backend.Backend,
@@ -120,74 +157,25 @@ func pullSnapshot(
 	pushedCP *checkpoint.Checkpoint,
 	initialServerSeq uint64,
 ) (*checkpoint.Checkpoint, []byte, error) {
-	snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if snapshotInfo.ServerSeq >= initialServerSeq {
-		pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
-		log.Logger.Infof(
-			"PULL: '%s' pulls snapshot without changes from '%s', cp: %s",
-			clientInfo.ID,
-			docInfo.Key,
-			pulledCP.String(),
-		)
-		return pushedCP.NextServerSeq(docInfo.ServerSeq), snapshotInfo.Snapshot, nil
-	}
-
-	docKey, err := docInfo.GetKey()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	doc, err := document.NewInternalDocumentFromSnapshot(
-		docKey.Collection,
-		docKey.Document,
-		snapshotInfo.ServerSeq,
-		snapshotInfo.Snapshot,
-	)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	// TODO(hackerwins): If the Snapshot is missing, we may have a very large
 	// number of changes to read at once here. We need to split changes by a
 	// certain size (e.g. 100) and read and gradually reflect it into the document.
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
-		ctx,
-		docInfo.ID,
-		snapshotInfo.ServerSeq+1,
-		initialServerSeq,
-	)
+	snapshot, err := snapshotAt(ctx, be, docInfo, initialServerSeq)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if err := doc.ApplyChangePack(change.NewPack(
-		docKey,
-		checkpoint.Initial.NextServerSeq(docInfo.ServerSeq),
-		changes,
-		nil,
-	)); err != nil {
-		return nil, nil, err
-	}
-
 	pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
 
 	log.Logger.Infof(
-		"PULL: '%s' pulls snapshot with changes(%d~%d) from '%s', cp: %s",
+		"PULL: '%s' pulls snapshot of serverSeq %d instead of changes(%d~%d) from '%s', cp: %s",
 		clientInfo.ID,
+		initialServerSeq,
 		pack.Checkpoint.ServerSeq+1,
 		initialServerSeq,
 		docInfo.Key,
 		pulledCP.String(),
 	)
 
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
-	if err != nil {
-		return nil, nil, err
-	}
-
 	return pulledCP, snapshot, nil
 }
@@ -39,6 +39,10 @@ func pushChanges(
 			cp = cp.NextServerSeq(serverSeq)
 			c.SetServerSeq(serverSeq)
 			pushedChanges = append(pushedChanges, c)
+
+			if err := clientInfo.UpdateLamport(docInfo.ID, c.ID().Lamport()); err != nil {
+				return nil, nil, err
+			}
 		} else {
 			log.Logger.Warnf("change is rejected: %d vs %d ", c.ID().ClientSeq(), cp.ClientSeq)
 		}
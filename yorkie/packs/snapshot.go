@@ -18,11 +18,15 @@ package packs
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 )
@@ -32,35 +36,163 @@ func storeSnapshot(
 	be *backend.Backend,
 	docInfo *db.DocInfo,
 ) error {
-	// 01. get the last snapshot of this docInfo
-	// TODO: For performance issue, we only need to read the snapshot's metadata.
-	snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+	// 01. get the metadata of the last snapshot of this docInfo
+	snapshotMeta, err := be.DB.FindLastSnapshotMeta(ctx, docInfo.ID)
 	if err != nil {
 		return err
 	}
 
-	if snapshotInfo.ServerSeq >= docInfo.ServerSeq {
+	if snapshotMeta.ServerSeq >= docInfo.ServerSeq {
 		return nil
 	}
-	if docInfo.ServerSeq-snapshotInfo.ServerSeq < be.Config.SnapshotInterval {
+	if docInfo.ServerSeq-snapshotMeta.ServerSeq < be.Config.SnapshotInterval {
 		return nil
 	}
 
-	// 02. retrieve the changes between last snapshot and current docInfo
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
-		ctx,
-		docInfo.ID,
-		snapshotInfo.ServerSeq+1,
+	// 02. encode the snapshot of the document of the docInfo, taken from the
+	// document cache or built from the last snapshot and the changes after it
+	var snapshot []byte
+	if err := withDocument(ctx, be, docInfo, docInfo.ServerSeq, func(doc *document.InternalDocument) error {
+		snapshot, err = converter.ObjectToSnapshot(
+			doc.RootObject(),
+			be.Config.ParseSnapshotCompression(),
+		)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// 03. save the snapshot after the cached document is released
+	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, snapshot); err != nil {
+		return err
+	}
+
+	log.Logger.Infof(
+		"SNAP: '%s', serverSeq: %d",
+		docInfo.Key,
 		docInfo.ServerSeq,
 	)
+	return nil
+}
+
+// FetchSnapshot returns a pack that has the snapshot of the given document at
+// the given server seq, or at its current server seq if it is 0. Unlike
+// PushPull, it does not touch the clients and the synced seqs, so the caller
+// does not appear as a peer and does not affect garbage collection. The
+// snapshot is encoded with one of the given snapshot compressions accepted by
+// the client.
+func FetchSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+	snapshotCompressions []api.SnapshotCompression,
+) (*ServerPack, error) {
+	docKey, err := docInfo.GetKey()
 	if err != nil {
+		return nil, err
+	}
+
+	if serverSeq == 0 {
+		serverSeq = docInfo.ServerSeq
+	}
+	if serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"server seq(document %d, requested %d): %w",
+			docInfo.ServerSeq,
+			serverSeq,
+			ErrInvalidServerSeq,
+		)
+	}
+
+	snapshot, err := snapshotAt(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err = encodeSnapshot(be, snapshot, snapshotCompressions)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Logger.Infof(
+		"FETCH: snapshot of '%s', serverSeq: %d",
+		docInfo.Key,
+		serverSeq,
+	)
+
+	pack := NewServerPack(
+		docKey,
+		checkpoint.Initial.NextServerSeq(serverSeq),
+		nil,
+		snapshot,
+	)
+	// NOTE: The fetched document is not attached, so there is nothing to
+	// collect. InitialTicket keeps the garbage of the snapshot as it is.
+	pack.MinSyncedTicket = time.InitialTicket
+	return pack, nil
+}
+
+// snapshotAt returns the snapshot of the given document at the given server
+// seq. The last snapshot is returned as it is if it is at the server seq. If
+// the last snapshot is ahead of the server seq, the snapshot is created from
+// the closest snapshot before it, otherwise from the document cache.
+func snapshotAt(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+) ([]byte, error) {
+	snapshotMeta, err := be.DB.FindLastSnapshotMeta(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshotMeta.ServerSeq > 0 && snapshotMeta.ServerSeq == serverSeq {
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		if err != nil {
+			return nil, err
+		}
+		return snapshotInfo.Snapshot, nil
+	}
+
+	// NOTE: The cached document can not be rolled back, so the document
+	//       behind the last snapshot is built from the closest snapshot.
+	if snapshotMeta.ServerSeq > serverSeq {
+		snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := buildDocument(ctx, be, docInfo, snapshotInfo, serverSeq)
+		if err != nil {
+			return nil, err
+		}
+		return converter.ObjectToBytes(doc.RootObject())
+	}
+
+	var snapshot []byte
+	if err := withDocument(ctx, be, docInfo, serverSeq, func(doc *document.InternalDocument) error {
+		snapshot, err = converter.ObjectToBytes(doc.RootObject())
 		return err
+	}); err != nil {
+		return nil, err
 	}
 
-	// 03. create document instance of the docInfo
+	return snapshot, nil
+}
+
+// buildDocument creates a document of the given docInfo by applying the
+// changes after the given snapshot up to the given server seq.
+func buildDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	snapshotInfo *db.SnapshotInfo,
+	serverSeq uint64,
+) (*document.InternalDocument, error) {
 	docKey, err := docInfo.GetKey()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	doc, err := document.NewInternalDocumentFromSnapshot(
@@ -69,28 +201,85 @@ func storeSnapshot(
 		snapshotInfo.ServerSeq,
 		snapshotInfo.Snapshot,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyChanges(ctx, be, docInfo.ID, doc, serverSeq); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// applyChanges applies the changes after the server seq of the given document
+// up to the given server seq.
+func applyChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docID db.ID,
+	doc *document.InternalDocument,
+	serverSeq uint64,
+) error {
+	if doc.Checkpoint().ServerSeq >= serverSeq {
+		return nil
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(
+		ctx,
+		docID,
+		doc.Checkpoint().ServerSeq+1,
+		serverSeq,
+	)
 	if err != nil {
 		return err
 	}
 
-	if err := doc.ApplyChangePack(change.NewPack(
-		docKey,
-		checkpoint.Initial.NextServerSeq(docInfo.ServerSeq),
+	return doc.ApplyChangePack(change.NewPack(
+		doc.Key(),
+		checkpoint.Initial.NextServerSeq(serverSeq),
 		changes,
 		nil,
-	)); err != nil {
-		return err
+	))
+}
+
+// encodeSnapshot encodes the given snapshot for the client that accepts the
+// given compressions. The configured compression is used if the client
+// accepts it, otherwise the snapshot is not compressed. Legacy clients that
+// accept no compression get the snapshot in the legacy format.
+func encodeSnapshot(
+	be *backend.Backend,
+	snapshot []byte,
+	accepted []api.SnapshotCompression,
+) ([]byte, error) {
+	if len(snapshot) == 0 {
+		return snapshot, nil
 	}
 
-	// 04. save the snapshot of the docInfo
-	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, doc); err != nil {
-		return err
+	if len(accepted) == 0 {
+		return converter.DecodeSnapshot(snapshot)
 	}
 
-	log.Logger.Infof(
-		"SNAP: '%s', serverSeq: %d",
-		docInfo.Key,
-		doc.Checkpoint().ServerSeq,
-	)
-	return nil
+	compression := api.SnapshotCompression_NO_COMPRESSION
+	preferred := be.Config.ParseSnapshotCompression()
+	for _, c := range accepted {
+		if c == preferred {
+			compression = preferred
+			break
+		}
+	}
+
+	// NOTE: The stored snapshot is sent as it is if it is already encoded
+	//       with the compression, to avoid decompressing and compressing it.
+	if converter.IsSnapshotEnvelope(snapshot) &&
+		converter.SnapshotCompressionOf(snapshot) == compression {
+		return snapshot, nil
+	}
+
+	raw, err := converter.DecodeSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.EncodeSnapshot(raw, compression)
 }
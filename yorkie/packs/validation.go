@@ -0,0 +1,202 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+var (
+	// ErrInvalidClientSeq is returned when the client seqs of the pushed
+	// changes are not contiguous with the stored client seq.
+	ErrInvalidClientSeq = errors.New("invalid client seq")
+
+	// ErrInvalidLamport is returned when the lamports of the pushed changes
+	// are not monotonic.
+	ErrInvalidLamport = errors.New("invalid lamport")
+
+	// ErrInvalidActor is returned when the pushed changes are made by an
+	// actor other than the client.
+	ErrInvalidActor = errors.New("invalid actor")
+
+	// ErrInvalidOperation is returned when the operations of the pushed
+	// changes reference elements that do not exist in the document.
+	ErrInvalidOperation = errors.New("invalid operation")
+)
+
+// validatePack validates the changes of the given pack that are not stored
+// yet, so that malformed packs do not corrupt the stored history.
+func validatePack(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	pack *change.Pack,
+) error {
+	if err := checkPackLimits(be, pack); err != nil {
+		return err
+	}
+
+	cp := clientInfo.Checkpoint(docInfo.ID)
+
+	var changes []*change.Change
+	for _, c := range pack.Changes {
+		if c.ClientSeq() > cp.ClientSeq {
+			changes = append(changes, c)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := validateChangeIDs(clientInfo, docInfo, cp, pack, changes); err != nil {
+		return err
+	}
+
+	return validateOperations(ctx, be, docInfo, changes)
+}
+
+// validateChangeIDs validates that the given changes are made by the client
+// with client seqs contiguous with the stored checkpoint up to the checkpoint
+// of the pack, and with lamports increasing from the last pushed change.
+func validateChangeIDs(
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	cp *checkpoint.Checkpoint,
+	pack *change.Pack,
+	changes []*change.Change,
+) error {
+	// NOTE: The client seq of a newly attached document starts from where
+	//       the replica of the client left off, which the server does not
+	//       know. In that case the changes must be all the changes up to the
+	//       checkpoint of the pack, so the baseline is the one the pack
+	//       declares instead of any client seq.
+	expectedClientSeq := cp.ClientSeq + 1
+	if cp.ClientSeq == 0 {
+		if pack.Checkpoint.ClientSeq < uint32(len(changes)) {
+			return fmt.Errorf(
+				"client seq(checkpoint %d, changes %d): %w",
+				pack.Checkpoint.ClientSeq,
+				len(changes),
+				ErrInvalidClientSeq,
+			)
+		}
+		expectedClientSeq = pack.Checkpoint.ClientSeq - uint32(len(changes)) + 1
+	}
+
+	lastLamport := clientInfo.Lamport(docInfo.ID)
+	for _, c := range changes {
+		if c.ID().Actor().String() != clientInfo.ID.String() {
+			return fmt.Errorf(
+				"change %d of %s: %w",
+				c.ClientSeq(),
+				c.ID().Actor().String(),
+				ErrInvalidActor,
+			)
+		}
+
+		if c.ClientSeq() != expectedClientSeq {
+			return fmt.Errorf(
+				"client seq(expected %d, given %d): %w",
+				expectedClientSeq,
+				c.ClientSeq(),
+				ErrInvalidClientSeq,
+			)
+		}
+		expectedClientSeq++
+
+		if c.ID().Lamport() <= lastLamport {
+			return fmt.Errorf(
+				"lamport(last %d, given %d): %w",
+				lastLamport,
+				c.ID().Lamport(),
+				ErrInvalidLamport,
+			)
+		}
+		lastLamport = c.ID().Lamport()
+	}
+
+	if lastClientSeq := expectedClientSeq - 1; lastClientSeq != pack.Checkpoint.ClientSeq {
+		return fmt.Errorf(
+			"client seq(checkpoint %d, given %d): %w",
+			pack.Checkpoint.ClientSeq,
+			lastClientSeq,
+			ErrInvalidClientSeq,
+		)
+	}
+
+	return nil
+}
+
+// validateOperations validates that the operations of the given changes can be
+// executed on the current document, and that they do not exceed the limits or
+// violate the schema of its collection further than the document already does.
+//
+// The changes are applied to the cached document in place under its lock, so
+// that the whole document is not copied on every push. The document is pending
+// until the changes are stored, and it is dropped if they are not valid.
+func validateOperations(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	changes []*change.Change,
+) error {
+	return withPendingDocument(ctx, be, docInfo, func(doc *document.InternalDocument) (err error) {
+		// NOTE: Operations of a malformed change can reference elements or
+		//       positions that do not exist, and the document panics on them,
+		//       so a panic while executing them is treated as an invalid
+		//       operation.
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v: %w", r, ErrInvalidOperation)
+			}
+		}()
+
+		before, err := measureDocument(be, doc)
+		if err != nil {
+			return err
+		}
+		violations := be.Schemas.Violations(doc.Key().Collection, doc.RootObject())
+
+		if err := doc.ApplyChangePack(change.NewPack(
+			doc.Key(),
+			doc.Checkpoint(),
+			changes,
+			nil,
+		)); err != nil {
+			return fmt.Errorf("%s: %w", err.Error(), ErrInvalidOperation)
+		}
+
+		after, err := measureDocument(be, doc)
+		if err != nil {
+			return err
+		}
+		if err := checkDocumentLimits(be, before, after); err != nil {
+			return err
+		}
+
+		return be.Schemas.ValidateChange(doc.Key().Collection, violations, doc.RootObject())
+	})
+}
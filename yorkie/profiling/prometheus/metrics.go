@@ -44,6 +44,13 @@ type Metrics struct {
 	pushPullSentOperationsTotal     prometheus.Counter
 	pushPullSnapshotDurationSeconds prometheus.Histogram
 	pushPullSnapshotBytesTotal      prometheus.Counter
+
+	limitExceededTotal *prometheus.CounterVec
+
+	docCacheHitsTotal   prometheus.Counter
+	docCacheMissesTotal prometheus.Counter
+
+	auditLogFailuresTotal prometheus.Counter
 }
 
 // NewMetrics creates a new instance of Metrics.
@@ -116,6 +123,30 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "snapshot_bytes_total",
 			Help:      "The total bytes of snapshots for response packs in PushPull.",
 		}),
+		limitExceededTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "limit",
+			Name:      "exceeded_total",
+			Help:      "The total count of requests rejected by exceeding the limits.",
+		}, []string{"limit"}),
+		docCacheHitsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "doc_cache",
+			Name:      "hits_total",
+			Help:      "The total count of documents taken from the document cache.",
+		}),
+		docCacheMissesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "doc_cache",
+			Name:      "misses_total",
+			Help:      "The total count of documents built from the database on cache misses.",
+		}),
+		auditLogFailuresTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "audit_log",
+			Name:      "failures_total",
+			Help:      "The total count of audit log entries that failed to be recorded.",
+		}),
 	}
 
 	metrics.agentVersion.With(prometheus.Labels{
@@ -166,6 +197,29 @@ func (m *Metrics) AddPushPullSnapshotBytes(bytes int) {
 	m.pushPullSnapshotBytesTotal.Add(float64(bytes))
 }
 
+// AddLimitExceeded adds a request rejected by exceeding the given limit.
+func (m *Metrics) AddLimitExceeded(limit string) {
+	m.limitExceededTotal.With(prometheus.Labels{
+		"limit": limit,
+	}).Inc()
+}
+
+// AddDocCacheHit adds the count of documents taken from the document cache.
+func (m *Metrics) AddDocCacheHit() {
+	m.docCacheHitsTotal.Inc()
+}
+
+// AddDocCacheMiss adds the count of documents built on document cache misses.
+func (m *Metrics) AddDocCacheMiss() {
+	m.docCacheMissesTotal.Inc()
+}
+
+// AddAuditLogFailures adds the count of audit log entries failed to be
+// recorded.
+func (m *Metrics) AddAuditLogFailures(count int) {
+	m.auditLogFailuresTotal.Add(float64(count))
+}
+
 // RegisterGRPCServer registers the given gRPC server.
 func (m *Metrics) RegisterGRPCServer(server *grpc.Server) {
 	m.serverMetrics.InitializeMetrics(server)
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+)
+
+// adminServer is a normal server that processes the requests of the operators
+// of the agent. The requests should be authenticated by the verified client
+// certificates of the internal subjects.
+type adminServer struct {
+	backend *backend.Backend
+}
+
+// newAdminServer creates a new instance of adminServer.
+func newAdminServer(be *backend.Backend) *adminServer {
+	return &adminServer{backend: be}
+}
+
+// PurgeAuthCache removes the cached decisions of the authorization webhook
+// selected by the given request from every agent of the cluster, so that the
+// revoked accesses are applied before the decisions expire. If the caches of
+// some members are not purged, it returns an error after purging the others,
+// and the request can be retried because purging is idempotent.
+func (s *adminServer) PurgeAuthCache(
+	ctx context.Context,
+	req *api.PurgeAuthCacheRequest,
+) (*api.PurgeAuthCacheResponse, error) {
+	operator, ok := auth.InternalSubjectFromCtx(ctx)
+	if !ok {
+		return nil, fmt.Errorf("purge auth cache: %w", auth.ErrCertificateRequired)
+	}
+
+	filter, err := toCacheFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	purged := auth.PurgeCache(s.backend, filter)
+	purgedByMembers, err := s.backend.Coordinator.PurgeAuthCache(ctx, req)
+	purged += purgedByMembers
+	log.Logger.Infof(
+		"auth cache purged by %s: subject: %q, document: %q, %d entries",
+		operator,
+		filter.Subject,
+		filter.DocKey,
+		purged,
+	)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return &api.PurgeAuthCacheResponse{PurgedCount: int32(purged)}, nil
+}
+
+// toCacheFilter converts the given request to the filter of the cached
+// decisions to purge.
+func toCacheFilter(req *api.PurgeAuthCacheRequest) (*auth.CacheFilter, error) {
+	filter := &auth.CacheFilter{
+		Token:   req.Token,
+		Subject: req.Subject,
+	}
+	if req.DocumentKey != nil {
+		docKey, err := converter.FromDocumentKey(req.DocumentKey)
+		if err != nil {
+			return nil, err
+		}
+		filter.DocKey = docKey.BSONKey()
+	}
+
+	return filter, nil
+}
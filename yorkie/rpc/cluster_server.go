@@ -2,23 +2,37 @@ package rpc
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	gosync "sync"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 )
 
 // clusterServer is a normal server that processes the broadcast by the agent.
 type clusterServer struct {
-	backend *backend.Backend
+	backend     *backend.Backend
+	memberAddrs *memberAddrs
 }
 
 // newClusterServer creates a new instance of clusterServer.
 func newClusterServer(be *backend.Backend) *clusterServer {
-	return &clusterServer{backend: be}
+	return &clusterServer{
+		backend:     be,
+		memberAddrs: newMemberAddrs(),
+	}
 }
 
 // BroadcastEvent publishes the given event to the given document.
@@ -26,6 +40,10 @@ func (s *clusterServer) BroadcastEvent(
 	ctx context.Context,
 	request *api.BroadcastEventRequest,
 ) (*api.BroadcastEventResponse, error) {
+	if err := s.verifyMember(ctx); err != nil {
+		return nil, err
+	}
+
 	actorID, err := time.ActorIDFromBytes(request.PublisherId)
 	if err != nil {
 		log.Logger.Error(err)
@@ -41,7 +59,8 @@ func (s *clusterServer) BroadcastEvent(
 	switch docEvent.Type {
 	case types.DocumentsWatchedEvent,
 		types.DocumentsUnwatchedEvent,
-		types.DocumentsChangedEvent:
+		types.DocumentsChangedEvent,
+		types.BroadcastEvent:
 		s.backend.Coordinator.PublishToLocal(ctx, actorID, *docEvent)
 	case types.MetadataChangedEvent:
 		if _, err := s.backend.Coordinator.UpdateMetadata(
@@ -57,3 +76,173 @@ func (s *clusterServer) BroadcastEvent(
 
 	return &api.BroadcastEventResponse{}, nil
 }
+
+// PurgeAuthCache removes the cached decisions of the authorization webhook
+// selected by the given request from the auth cache of this agent. It is
+// called by the member that received the request of the operator.
+func (s *clusterServer) PurgeAuthCache(
+	ctx context.Context,
+	req *api.PurgeAuthCacheRequest,
+) (*api.PurgeAuthCacheResponse, error) {
+	if err := s.verifyMember(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := toCacheFilter(req)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	purged := auth.PurgeCache(s.backend, filter)
+	return &api.PurgeAuthCacheResponse{PurgedCount: int32(purged)}, nil
+}
+
+// verifyMember verifies that the caller of the given context is a member of
+// the cluster. The caller should carry the cluster secret if it is configured,
+// and the peer of the request should be the member of the given agent ID.
+func (s *clusterServer) verifyMember(ctx context.Context) error {
+	data, _ := metadata.FromIncomingContext(ctx)
+
+	if s.backend.ClusterSecret != nil {
+		secrets := data.Get(sync.ClusterSecretMetadataKey)
+		if len(secrets) == 0 || subtle.ConstantTimeCompare([]byte(secrets[0]), s.backend.ClusterSecret) != 1 {
+			return fmt.Errorf("invalid cluster secret: %w", sync.ErrNotClusterMember)
+		}
+	}
+
+	agentIDs := data.Get(sync.AgentIDMetadataKey)
+	if len(agentIDs) == 0 {
+		return fmt.Errorf("agent ID is not provided: %w", sync.ErrNotClusterMember)
+	}
+	members := s.backend.Members()
+	member, ok := members[agentIDs[0]]
+	if !ok {
+		return fmt.Errorf("agent %s: %w", agentIDs[0], sync.ErrNotClusterMember)
+	}
+
+	return s.verifyPeer(ctx, member, members)
+}
+
+// verifyPeer verifies that the peer of the given context is the given member.
+// The peer is the member if its verified client certificate is issued for the
+// host of the RPC address of the member. Otherwise, if the caller carried the
+// cluster secret or the cluster is insecure, the peer is the member if its
+// address is one of the addresses of the host.
+func (s *clusterServer) verifyPeer(
+	ctx context.Context,
+	member *sync.AgentInfo,
+	members map[string]*sync.AgentInfo,
+) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return fmt.Errorf("agent %s: peer is not provided: %w", member.ID, sync.ErrNotClusterMember)
+	}
+
+	host, _, err := net.SplitHostPort(member.RPCAddr)
+	if err != nil {
+		return fmt.Errorf("agent %s: %s: %w", member.ID, err.Error(), sync.ErrNotClusterMember)
+	}
+
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok &&
+		len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		if tlsInfo.State.VerifiedChains[0][0].VerifyHostname(host) == nil {
+			return nil
+		}
+	}
+
+	// NOTE: The address of the peer can be spoofed in the networks between
+	//       the agents, so it is not trusted alone unless the cluster is
+	//       configured to be insecure.
+	if s.backend.ClusterSecret == nil && !s.backend.ClusterInsecure {
+		return fmt.Errorf(
+			"agent %s: neither cluster secret nor certificate is verified: %w",
+			member.ID,
+			sync.ErrNotClusterMember,
+		)
+	}
+
+	peerHost, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return fmt.Errorf("agent %s: %s: %w", member.ID, err.Error(), sync.ErrNotClusterMember)
+	}
+	peerIP := net.ParseIP(peerHost)
+
+	ips, err := s.memberAddrs.lookup(ctx, member, members)
+	if err != nil {
+		log.Logger.Error(err)
+		return fmt.Errorf("agent %s: %s: %w", member.ID, err.Error(), sync.ErrNotClusterMember)
+	}
+	for _, ip := range ips {
+		if ip.Equal(peerIP) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("agent %s from %s: %w", member.ID, peerHost, sync.ErrNotClusterMember)
+}
+
+// memberAddrs caches the addresses resolved from the RPC hosts of the members
+// by their IDs, so that the host of a member is resolved only when the member
+// joins or changes its RPC address, not on every cluster request.
+type memberAddrs struct {
+	mu    gosync.Mutex
+	addrs map[string]*resolvedAddrs
+}
+
+// resolvedAddrs is the addresses resolved from the RPC address of a member.
+type resolvedAddrs struct {
+	rpcAddr string
+	ips     []net.IP
+}
+
+// newMemberAddrs creates a new instance of memberAddrs.
+func newMemberAddrs() *memberAddrs {
+	return &memberAddrs{addrs: make(map[string]*resolvedAddrs)}
+}
+
+// lookup returns the addresses of the RPC host of the given member. The
+// addresses of the members that are no longer in the given members are
+// dropped when the host of a member is resolved.
+func (m *memberAddrs) lookup(
+	ctx context.Context,
+	member *sync.AgentInfo,
+	members map[string]*sync.AgentInfo,
+) ([]net.IP, error) {
+	m.mu.Lock()
+	resolved, ok := m.addrs[member.ID]
+	m.mu.Unlock()
+	if ok && resolved.rpcAddr == member.RPCAddr {
+		return resolved.ips, nil
+	}
+
+	host, _, err := net.SplitHostPort(member.RPCAddr)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []net.IP
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id := range m.addrs {
+		if _, ok := members[id]; !ok {
+			delete(m.addrs, id)
+		}
+	}
+	m.addrs[member.ID] = &resolvedAddrs{
+		rpcAddr: member.RPCAddr,
+		ips:     ips,
+	}
+
+	return ips, nil
+}
@@ -29,6 +29,10 @@ var (
 	ErrInvalidCertFile = errors.New("invalid cert file for RPC server")
 	// ErrInvalidKeyFile occurs when the key file is invalid.
 	ErrInvalidKeyFile = errors.New("invalid key file for RPC server")
+	// ErrInvalidClientCAFile occurs when the client CA file is invalid.
+	ErrInvalidClientCAFile = errors.New("invalid client CA file for RPC server")
+	// ErrInvalidRateLimit occurs when the rate limit is invalid.
+	ErrInvalidRateLimit = errors.New("invalid rate limit for RPC server")
 )
 
 // Config is the configuration for creating a Server instance.
@@ -42,8 +46,44 @@ type Config struct {
 	// KeyFile is the path to the key file.
 	KeyFile string
 
+	// ClientCAFile is the path to the file of the CA certificates to verify
+	// the client certificates. The clients with verified certificates are
+	// authenticated by the subjects of the certificates, and their accesses
+	// are authorized by the webhook or the JWT. If it is empty, the client
+	// certificates are not requested.
+	ClientCAFile string
+
+	// InternalSubjects is the subjects of the client certificates of the
+	// internal services, such as "CN=yorkie-admin". The clients with them are
+	// granted all accesses without tokens and can call the admin service.
+	InternalSubjects []string
+
 	// MaxRequestBytes is the maximum client request size in bytes the server will accept.
 	MaxRequestBytes uint64
+
+	// ClientRateLimit is the number of calls per second allowed for a client,
+	// identified by its client ID. The calls without client IDs, such as
+	// ActivateClient, are limited by the subject of the certificate or the
+	// peer address of the caller, so the callers behind the same proxy or NAT
+	// share the limit of those calls. Zero disables the limit.
+	ClientRateLimit float64
+
+	// ClientRateBurst is the max number of calls a client can make at once.
+	ClientRateBurst int
+
+	// TokenRateLimit is the number of calls per second allowed for an auth
+	// token. Zero disables the limit.
+	TokenRateLimit float64
+
+	// TokenRateBurst is the max number of calls with an auth token at once.
+	TokenRateBurst int
+
+	// DocumentRateLimit is the number of calls per second allowed for a
+	// document. Zero disables the limit.
+	DocumentRateLimit float64
+
+	// DocumentRateBurst is the max number of calls for a document at once.
+	DocumentRateBurst int
 }
 
 // Validate validates the port number and the files for certification.
@@ -65,5 +105,41 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.ClientCAFile != "" {
+		if c.CertFile == "" || c.KeyFile == "" {
+			return fmt.Errorf("%s: cert and key files are required: %w", c.ClientCAFile, ErrInvalidClientCAFile)
+		}
+		if _, err := os.Stat(c.ClientCAFile); err != nil {
+			return fmt.Errorf("%s: %w", c.ClientCAFile, ErrInvalidClientCAFile)
+		}
+	}
+
+	if len(c.InternalSubjects) > 0 && c.ClientCAFile == "" {
+		return fmt.Errorf("internal subjects require the client CA file: %w", ErrInvalidClientCAFile)
+	}
+
+	if err := validateRateLimit("client", c.ClientRateLimit, c.ClientRateBurst); err != nil {
+		return err
+	}
+	if err := validateRateLimit("token", c.TokenRateLimit, c.TokenRateBurst); err != nil {
+		return err
+	}
+	if err := validateRateLimit("document", c.DocumentRateLimit, c.DocumentRateBurst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateRateLimit validates the rate and the burst of the given limit.
+func validateRateLimit(name string, rate float64, burst int) error {
+	if rate < 0 {
+		return fmt.Errorf("%s rate must not be negative, given %f: %w", name, rate, ErrInvalidRateLimit)
+	}
+
+	if rate > 0 && burst < 1 {
+		return fmt.Errorf("%s burst must be positive, given %d: %w", name, burst, ErrInvalidRateLimit)
+	}
+
 	return nil
 }
@@ -18,25 +18,49 @@ package interceptors
 
 import (
 	"context"
+	"strings"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/yorkie/auth"
 )
 
+// clusterServicePrefix is the prefix of the methods of the cluster service.
+// The cluster requests are authenticated by the cluster server as the requests
+// of the agents, not of the users.
+const clusterServicePrefix = "/api.Cluster/"
+
 // AuthInterceptor is an interceptor for authentication.
 type AuthInterceptor struct {
-	webhook string
+	webhook          string
+	verifier         *auth.JWTVerifier
+	internalSubjects map[string]bool
 }
 
-// NewAuthInterceptor creates a new instance of AuthInterceptor.
-func NewAuthInterceptor(webhook string) *AuthInterceptor {
+// NewAuthInterceptor creates a new instance of AuthInterceptor. If the given
+// verifier is not nil, tokens are verified as JWTs before the handlers. The
+// clients with the verified certificates of the given internal subjects are
+// trusted as internal services.
+func NewAuthInterceptor(
+	webhook string,
+	verifier *auth.JWTVerifier,
+	internalSubjects []string,
+) *AuthInterceptor {
+	subjects := make(map[string]bool)
+	for _, subject := range internalSubjects {
+		subjects[subject] = true
+	}
+
 	return &AuthInterceptor{
-		webhook: webhook,
+		webhook:          webhook,
+		verifier:         verifier,
+		internalSubjects: subjects,
 	}
 }
 
@@ -48,12 +72,12 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		if i.needAuth() {
-			token, err := i.extractToken(ctx)
+		if !strings.HasPrefix(info.FullMethod, clusterServicePrefix) && i.needAuth(ctx) {
+			authCtx, err := i.authenticate(ctx)
 			if err != nil {
 				return nil, err
 			}
-			return handler(auth.CtxWithToken(ctx, token), req)
+			return handler(authCtx, req)
 		}
 
 		return handler(ctx, req)
@@ -68,13 +92,13 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		if i.needAuth() {
-			token, err := i.extractToken(ss.Context())
+		if !strings.HasPrefix(info.FullMethod, clusterServicePrefix) && i.needAuth(ss.Context()) {
+			authCtx, err := i.authenticate(ss.Context())
 			if err != nil {
 				return err
 			}
 			wrapped := grpcmiddleware.WrapServerStream(ss)
-			wrapped.WrappedContext = auth.CtxWithToken(ss.Context(), token)
+			wrapped.WrappedContext = authCtx
 			return handler(srv, wrapped)
 		}
 
@@ -82,8 +106,45 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 	}
 }
 
-func (i *AuthInterceptor) needAuth() bool {
-	return len(i.webhook) > 0
+func (i *AuthInterceptor) needAuth(ctx context.Context) bool {
+	if len(i.webhook) > 0 || i.verifier != nil {
+		return true
+	}
+
+	_, ok := certificateSubject(ctx)
+	return ok
+}
+
+// authenticate returns a new context with the token of the given context. If
+// the verifier is given, the claims of the verified token are also included.
+// The requests with verified client certificates are authenticated by the
+// subjects of the certificates, and the tokens are not required for the
+// webhook. Only the requests of the internal subjects skip the verification
+// of the tokens.
+func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	subject, hasCertificate := certificateSubject(ctx)
+	token, err := i.extractToken(ctx)
+	if err != nil && !hasCertificate {
+		return nil, err
+	}
+	ctx = auth.CtxWithPrincipal(auth.CtxWithToken(ctx, token))
+
+	if hasCertificate {
+		ctx = auth.CtxWithCertificateSubject(ctx, subject)
+		if i.internalSubjects[subject] {
+			return auth.CtxWithInternalSubject(ctx, subject), nil
+		}
+	}
+
+	if i.verifier != nil {
+		claims, err := i.verifier.Verify(token)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		ctx = auth.CtxWithClaims(ctx, claims)
+	}
+
+	return ctx, nil
 }
 
 func (i *AuthInterceptor) extractToken(ctx context.Context) (string, error) {
@@ -99,3 +160,20 @@ func (i *AuthInterceptor) extractToken(ctx context.Context) (string, error) {
 
 	return values[0], nil
 }
+
+// certificateSubject returns the subject of the client certificate of the given
+// context. Only the certificates verified with the CA of the server are
+// considered.
+func certificateSubject(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0].Subject.String(), true
+}
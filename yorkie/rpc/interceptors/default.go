@@ -21,6 +21,7 @@ import (
 	"errors"
 	gotime "time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,10 +29,14 @@ import (
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/audit"
 	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/clients"
 	"github.com/yorkie-team/yorkie/yorkie/packs"
+	"github.com/yorkie-team/yorkie/yorkie/schema"
 )
 
 // DefaultInterceptor is a interceptor for default.
@@ -87,21 +92,45 @@ func (i *DefaultInterceptor) Stream() grpc.StreamServerInterceptor {
 // occurs while executing logic in API handler, gRPC status.error should be
 // returned so that the client can know more about the status of the request.
 func toStatusError(err error) error {
+	if errors.Is(err, auth.ErrTokenExpired) {
+		return tokenExpiredError(err)
+	}
+
 	if errors.Is(err, auth.ErrNotAllowed) ||
 		errors.Is(err, auth.ErrUnexpectedStatusCode) ||
-		errors.Is(err, auth.ErrWebhookTimeout) {
+		errors.Is(err, auth.ErrWebhookTimeout) ||
+		errors.Is(err, auth.ErrInvalidToken) {
 		return status.Error(codes.Unauthenticated, err.Error())
 	}
 
+	if errors.Is(err, db.ErrClientSubjectMismatch) ||
+		errors.Is(err, sync.ErrNotClusterMember) ||
+		errors.Is(err, auth.ErrCertificateRequired) ||
+		errors.Is(err, auth.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
 	if errors.Is(err, converter.ErrPackRequired) ||
 		errors.Is(err, converter.ErrCheckpointRequired) ||
+		errors.Is(err, converter.ErrDocumentKeyRequired) ||
+		errors.Is(err, converter.ErrCollectionRequired) ||
 		errors.Is(err, time.ErrInvalidHexString) ||
 		errors.Is(err, db.ErrInvalidID) ||
+		errors.Is(err, db.ErrInvalidChangeCursor) ||
 		errors.Is(err, clients.ErrInvalidClientID) ||
-		errors.Is(err, clients.ErrInvalidClientKey) {
+		errors.Is(err, clients.ErrInvalidClientKey) ||
+		errors.Is(err, packs.ErrInvalidLamport) ||
+		errors.Is(err, packs.ErrInvalidActor) ||
+		errors.Is(err, packs.ErrInvalidOperation) ||
+		errors.Is(err, schema.ErrSchemaViolation) ||
+		errors.Is(err, sync.ErrPayloadTooLarge) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if errors.Is(err, packs.ErrLimitExceeded) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
 	if errors.Is(err, converter.ErrUnsupportedOperation) ||
 		errors.Is(err, converter.ErrUnsupportedElement) ||
 		errors.Is(err, converter.ErrUnsupportedEventType) ||
@@ -119,9 +148,34 @@ func toStatusError(err error) error {
 		err == db.ErrDocumentNotAttached ||
 		err == db.ErrDocumentAlreadyAttached ||
 		errors.Is(err, packs.ErrInvalidServerSeq) ||
-		errors.Is(err, db.ErrConflictOnUpdate) {
+		errors.Is(err, packs.ErrInvalidClientSeq) ||
+		errors.Is(err, db.ErrConflictOnUpdate) ||
+		errors.Is(err, sync.ErrNotSubscribed) {
 		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
+	if errors.Is(err, sync.ErrPurgeFailed) ||
+		errors.Is(err, audit.ErrRecordFailed) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
 	return status.Error(codes.Internal, err.Error())
 }
+
+// tokenExpiredError returns Unauthenticated with the ErrorInfo of
+// TokenExpiredReason, so that the client can tell the expired token from the
+// other authentication failures and refresh it.
+func tokenExpiredError(err error) error {
+	st := status.New(codes.Unauthenticated, err.Error())
+
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: types.TokenExpiredReason,
+		Domain: "yorkie",
+	})
+	if detailErr != nil {
+		log.Logger.Error(detailErr)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
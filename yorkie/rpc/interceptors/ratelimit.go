@@ -0,0 +1,346 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"net"
+	"strings"
+	gosync "sync"
+	gotime "time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+)
+
+// bucketCacheSize is the max number of token buckets kept for each kind of
+// key. The least recently used buckets are evicted beyond this size.
+const bucketCacheSize = 10000
+
+// RateLimit is the configuration of a token bucket.
+type RateLimit struct {
+	// Rate is the number of tokens refilled per second. Zero disables the
+	// limit.
+	Rate float64
+
+	// Burst is the max number of tokens in the bucket.
+	Burst int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.Rate > 0
+}
+
+// refillDuration returns the duration for an empty bucket to be full.
+func (l RateLimit) refillDuration() gotime.Duration {
+	return gotime.Duration(float64(l.Burst) / l.Rate * float64(gotime.Second))
+}
+
+// bucket is a token bucket that is refilled lazily when tokens are taken.
+type bucket struct {
+	tokens    float64
+	updatedAt gotime.Time
+}
+
+// limiter is a set of token buckets of the same limit, by key.
+type limiter struct {
+	limit   RateLimit
+	buckets *cache.LRUExpireCache
+}
+
+func newLimiter(limit RateLimit) (*limiter, error) {
+	if !limit.enabled() {
+		return nil, nil
+	}
+
+	buckets, err := cache.NewLRUExpireCache(bucketCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &limiter{
+		limit:   limit,
+		buckets: buckets,
+	}, nil
+}
+
+// bucket returns the bucket of the given key refilled until now.
+func (l *limiter) bucket(key string, now gotime.Time) *bucket {
+	b := &bucket{tokens: float64(l.limit.Burst), updatedAt: now}
+	if value, ok := l.buckets.Get(key); ok {
+		b = value.(*bucket)
+		b.tokens = math.Min(
+			float64(l.limit.Burst),
+			b.tokens+now.Sub(b.updatedAt).Seconds()*l.limit.Rate,
+		)
+		b.updatedAt = now
+	}
+
+	// NOTE: A bucket is full again after the refill duration since it was
+	//       last updated, so the bucket is added again with a fresh TTL, and
+	//       an expired bucket can be replaced with a full one.
+	l.buckets.Add(key, b, l.limit.refillDuration())
+	return b
+}
+
+// RateLimitInterceptor is an interceptor that limits the rate of RPC calls by
+// client, by auth token and by document. Clients are identified by the client
+// IDs of the requests, which are bound to the subjects of the callers, and the
+// requests without client IDs are limited by the subjects of the verified
+// certificates or the peer addresses of the callers. The calls of the cluster
+// service between the agents are not limited.
+type RateLimitInterceptor struct {
+	// mu makes taking tokens from the buckets of a request atomic.
+	mu gosync.Mutex
+
+	clientLimiter   *limiter
+	tokenLimiter    *limiter
+	documentLimiter *limiter
+}
+
+// NewRateLimitInterceptor creates a new instance of RateLimitInterceptor.
+func NewRateLimitInterceptor(
+	clientLimit RateLimit,
+	tokenLimit RateLimit,
+	documentLimit RateLimit,
+) (*RateLimitInterceptor, error) {
+	clientLimiter, err := newLimiter(clientLimit)
+	if err != nil {
+		return nil, err
+	}
+	tokenLimiter, err := newLimiter(tokenLimit)
+	if err != nil {
+		return nil, err
+	}
+	documentLimiter, err := newLimiter(documentLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RateLimitInterceptor{
+		clientLimiter:   clientLimiter,
+		tokenLimiter:    tokenLimiter,
+		documentLimiter: documentLimiter,
+	}, nil
+}
+
+// Unary creates a unary server interceptor for rate limiting.
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, clusterServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		if err := i.allow(ctx, req); err != nil {
+			log.Logger.Warnf("RPC : %q => %q", info.FullMethod, err)
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates a stream server interceptor for rate limiting. Each message
+// received from the stream is limited.
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if strings.HasPrefix(info.FullMethod, clusterServicePrefix) {
+			return handler(srv, ss)
+		}
+
+		return handler(srv, &rateLimitedServerStream{
+			ServerStream: ss,
+			interceptor:  i,
+		})
+	}
+}
+
+// allow takes a token from each bucket of the given request. If any of the
+// buckets is empty, no token is taken and ResourceExhausted is returned with
+// the delay after which the request can be retried.
+func (i *RateLimitInterceptor) allow(ctx context.Context, req interface{}) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := gotime.Now()
+	var buckets []*bucket
+	var retryDelay gotime.Duration
+
+	take := func(l *limiter, key string) {
+		if l == nil || key == "" {
+			return
+		}
+
+		b := l.bucket(key, now)
+		if b.tokens < 1 {
+			delay := gotime.Duration((1 - b.tokens) / l.limit.Rate * float64(gotime.Second))
+			if delay > retryDelay {
+				retryDelay = delay
+			}
+			return
+		}
+		buckets = append(buckets, b)
+	}
+
+	take(i.clientLimiter, clientOf(ctx, req))
+	take(i.tokenLimiter, tokenOf(ctx))
+	for _, docKey := range documentKeysOf(req) {
+		take(i.documentLimiter, docKey)
+	}
+
+	if retryDelay > 0 {
+		return rateLimitedError(retryDelay)
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return nil
+}
+
+// rateLimitedError returns ResourceExhausted with the retry delay.
+func rateLimitedError(retryDelay gotime.Duration) error {
+	st := status.Newf(
+		codes.ResourceExhausted,
+		"rate limit exceeded, retry after %s",
+		retryDelay,
+	)
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// clientOf returns the identity of the client of the given request: the client
+// ID of the request, or the caller of the given context if the request has no
+// client ID.
+func clientOf(ctx context.Context, req interface{}) string {
+	var clientID []byte
+	switch req := req.(type) {
+	case interface{ GetClientId() []byte }:
+		clientID = req.GetClientId()
+	case interface{ GetClient() *api.Client }:
+		clientID = req.GetClient().GetId()
+	}
+	if len(clientID) > 0 {
+		return "client:" + hex.EncodeToString(clientID)
+	}
+
+	return callerOf(ctx)
+}
+
+// callerOf returns the identity of the caller of the given context: the
+// subject of the verified client certificate, or the host of the peer address.
+func callerOf(ctx context.Context) string {
+	if subject, ok := auth.CertificateSubjectFromCtx(ctx); ok {
+		return "subject:" + subject
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "addr:" + p.Addr.String()
+	}
+	return "addr:" + host
+}
+
+// tokenOf returns the auth token of the given context.
+func tokenOf(ctx context.Context) string {
+	data, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := data["authorization"]
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// documentKeysOf returns the document keys of the given request.
+func documentKeysOf(req interface{}) []string {
+	var pbKeys []*api.DocumentKey
+	switch req := req.(type) {
+	case interface{ GetChangePack() *api.ChangePack }:
+		pbKeys = append(pbKeys, req.GetChangePack().GetDocumentKey())
+	case interface{ GetDocumentKey() *api.DocumentKey }:
+		pbKeys = append(pbKeys, req.GetDocumentKey())
+	case interface{ GetDocumentKeys() []*api.DocumentKey }:
+		pbKeys = req.GetDocumentKeys()
+	case interface{ GetAddedDocumentKeys() []*api.DocumentKey }:
+		pbKeys = req.GetAddedDocumentKeys()
+	}
+
+	var keys []string
+	for _, pbKey := range pbKeys {
+		if pbKey == nil {
+			continue
+		}
+		keys = append(keys, pbKey.Collection+"$"+pbKey.Document)
+	}
+	return keys
+}
+
+// rateLimitedServerStream is a grpc.ServerStream that limits the rate of the
+// received messages.
+type rateLimitedServerStream struct {
+	grpc.ServerStream
+	interceptor *RateLimitInterceptor
+}
+
+// RecvMsg receives a message from the stream, then takes tokens for it.
+func (s *rateLimitedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.interceptor.allow(s.Context(), m)
+}
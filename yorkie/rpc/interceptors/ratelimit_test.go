@@ -0,0 +1,150 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Yorkie/PushPull"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+	pushPullReq := func(clientID byte, document string) *api.PushPullRequest {
+		return &api.PushPullRequest{
+			ClientId: []byte{clientID},
+			ChangePack: &api.ChangePack{
+				DocumentKey: &api.DocumentKey{Collection: "c1", Document: document},
+			},
+		}
+	}
+
+	t.Run("client rate limit test", func(t *testing.T) {
+		interceptor, err := interceptors.NewRateLimitInterceptor(
+			interceptors.RateLimit{Rate: 0.001, Burst: 2},
+			interceptors.RateLimit{},
+			interceptors.RateLimit{},
+		)
+		assert.NoError(t, err)
+		unary := interceptor.Unary()
+
+		peerCtx := func(ip string, port int) context.Context {
+			return peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: port},
+			})
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err = unary(peerCtx("10.0.0.1", 1000), pushPullReq(1, "d1"), info, handler)
+			assert.NoError(t, err)
+		}
+
+		// the client is identified by the client ID, not by the peer address
+		_, err = unary(peerCtx("10.0.0.2", 1000), pushPullReq(1, "d1"), info, handler)
+		st := status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Len(t, st.Details(), 1)
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		assert.True(t, ok)
+		assert.True(t, retryInfo.RetryDelay.AsDuration() > 0)
+
+		// another client behind the same address is not limited
+		_, err = unary(peerCtx("10.0.0.1", 1000), pushPullReq(2, "d1"), info, handler)
+		assert.NoError(t, err)
+
+		// the requests without client IDs are limited by the peer address
+		activateInfo := &grpc.UnaryServerInfo{FullMethod: "/api.Yorkie/ActivateClient"}
+		for i := 0; i < 2; i++ {
+			_, err = unary(peerCtx("10.0.0.3", 1000), &api.ActivateClientRequest{}, activateInfo, handler)
+			assert.NoError(t, err)
+		}
+		_, err = unary(peerCtx("10.0.0.3", 1001), &api.ActivateClientRequest{}, activateInfo, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// the calls of the cluster service are not limited
+		broadcastInfo := &grpc.UnaryServerInfo{FullMethod: "/api.Cluster/BroadcastEvent"}
+		for i := 0; i < 3; i++ {
+			_, err = unary(peerCtx("10.0.0.4", 1000), &api.BroadcastEventRequest{}, broadcastInfo, handler)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("token rate limit test", func(t *testing.T) {
+		interceptor, err := interceptors.NewRateLimitInterceptor(
+			interceptors.RateLimit{},
+			interceptors.RateLimit{Rate: 0.001, Burst: 1},
+			interceptors.RateLimit{},
+		)
+		assert.NoError(t, err)
+		unary := interceptor.Unary()
+
+		ctx := metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "token1"),
+		)
+		_, err = unary(ctx, pushPullReq(1, "d1"), info, handler)
+		assert.NoError(t, err)
+		_, err = unary(ctx, pushPullReq(2, "d2"), info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// requests without a token are not limited
+		_, err = unary(context.Background(), pushPullReq(1, "d1"), info, handler)
+		assert.NoError(t, err)
+	})
+
+	t.Run("document rate limit test", func(t *testing.T) {
+		interceptor, err := interceptors.NewRateLimitInterceptor(
+			interceptors.RateLimit{},
+			interceptors.RateLimit{},
+			interceptors.RateLimit{Rate: 0.001, Burst: 1},
+		)
+		assert.NoError(t, err)
+		unary := interceptor.Unary()
+
+		_, err = unary(context.Background(), pushPullReq(1, "d1"), info, handler)
+		assert.NoError(t, err)
+		_, err = unary(context.Background(), pushPullReq(2, "d1"), info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		_, err = unary(context.Background(), &api.WatchDocumentsRequest{
+			Client: &api.Client{Id: []byte{3}},
+			DocumentKeys: []*api.DocumentKey{
+				{Collection: "c1", Document: "d2"},
+				{Collection: "c1", Document: "d1"},
+			},
+		}, info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// no token is taken from d2 by the rejected request
+		_, err = unary(context.Background(), pushPullReq(4, "d2"), info, handler)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"runtime/debug"
+
+	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/internal/log"
+)
+
+// RecoveryInterceptor is an interceptor that turns the panics of the handlers
+// into Internal errors, so that a malformed request can not crash the agent.
+type RecoveryInterceptor struct {
+	opts []grpcrecovery.Option
+}
+
+// NewRecoveryInterceptor creates a new instance of RecoveryInterceptor.
+func NewRecoveryInterceptor() *RecoveryInterceptor {
+	return &RecoveryInterceptor{
+		opts: []grpcrecovery.Option{grpcrecovery.WithRecoveryHandler(recoverPanic)},
+	}
+}
+
+// Unary creates a unary server interceptor for recovery.
+func (i *RecoveryInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return grpcrecovery.UnaryServerInterceptor(i.opts...)
+}
+
+// Stream creates a stream server interceptor for recovery.
+func (i *RecoveryInterceptor) Stream() grpc.StreamServerInterceptor {
+	return grpcrecovery.StreamServerInterceptor(i.opts...)
+}
+
+// recoverPanic logs the given panic with the stack and returns Internal.
+func recoverPanic(p interface{}) error {
+	log.Logger.Errorf("RPC : panic: %v\n%s", p, debug.Stack())
+	return status.Errorf(codes.Internal, "panic: %v", p)
+}
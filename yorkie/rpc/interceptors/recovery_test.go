@@ -0,0 +1,47 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Run("recover panic test", func(t *testing.T) {
+		unary := interceptors.NewRecoveryInterceptor().Unary()
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.Yorkie/PushPull"}
+
+		_, err := unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("the node of the given id should be found")
+		})
+		assert.Equal(t, codes.Internal, status.Convert(err).Code())
+
+		resp, err := unary(context.Background(), "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return req, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "req", resp)
+	})
+}
@@ -18,9 +18,13 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"net"
+	"path/filepath"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
@@ -30,6 +34,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
 )
@@ -43,24 +48,45 @@ type Server struct {
 
 // NewServer creates a new instance of Server.
 func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
-	authInterceptor := interceptors.NewAuthInterceptor(be.Config.AuthWebhookURL)
+	jwtVerifier, err := auth.NewJWTVerifier(be.Config)
+	if err != nil {
+		return nil, err
+	}
+	authInterceptor := interceptors.NewAuthInterceptor(
+		be.Config.AuthWebhookURL,
+		jwtVerifier,
+		conf.InternalSubjects,
+	)
+	rateLimitInterceptor, err := interceptors.NewRateLimitInterceptor(
+		interceptors.RateLimit{Rate: conf.ClientRateLimit, Burst: conf.ClientRateBurst},
+		interceptors.RateLimit{Rate: conf.TokenRateLimit, Burst: conf.TokenRateBurst},
+		interceptors.RateLimit{Rate: conf.DocumentRateLimit, Burst: conf.DocumentRateBurst},
+	)
+	if err != nil {
+		return nil, err
+	}
 	defaultInterceptor := interceptors.NewDefaultInterceptor()
+	recoveryInterceptor := interceptors.NewRecoveryInterceptor()
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
 			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
+			recoveryInterceptor.Unary(),
 			authInterceptor.Unary(),
+			rateLimitInterceptor.Unary(),
 			defaultInterceptor.Unary(),
 		)),
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
 			be.Metrics.ServerMetrics().StreamServerInterceptor(),
+			recoveryInterceptor.Stream(),
 			authInterceptor.Stream(),
+			rateLimitInterceptor.Stream(),
 			defaultInterceptor.Stream(),
 		)),
 	}
 
 	if conf.CertFile != "" && conf.KeyFile != "" {
-		creds, err := credentials.NewServerTLSFromFile(conf.CertFile, conf.KeyFile)
+		creds, err := newServerCredentials(conf)
 		if err != nil {
 			log.Logger.Error(err)
 			return nil, err
@@ -78,6 +104,7 @@ func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
 	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
 	api.RegisterYorkieServer(grpcServer, newYorkieServer(yorkieServiceCtx, be))
 	api.RegisterClusterServer(grpcServer, newClusterServer(be))
+	api.RegisterAdminServer(grpcServer, newAdminServer(be))
 	be.Metrics.RegisterGRPCServer(grpcServer)
 
 	return &Server{
@@ -87,6 +114,36 @@ func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
 	}, nil
 }
 
+// newServerCredentials creates the TLS credentials of the server. If the client
+// CA file is given, the client certificates are verified with it.
+func newServerCredentials(conf *Config) (credentials.TransportCredentials, error) {
+	if conf.ClientCAFile == "" {
+		return credentials.NewServerTLSFromFile(conf.CertFile, conf.KeyFile)
+	}
+
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	caCerts, err := ioutil.ReadFile(filepath.Clean(conf.ClientCAFile))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCerts) {
+		return nil, fmt.Errorf("%s: no certificate: %w", conf.ClientCAFile, ErrInvalidClientCAFile)
+	}
+
+	// NOTE: The certificates are verified only if the clients present them,
+	//       so that the clients with tokens can connect without certificates.
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	}), nil
+}
+
 // Start starts this server by opening the rpc port.
 func (s *Server) Start() error {
 	return s.listenAndServeGRPC()
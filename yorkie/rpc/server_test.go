@@ -14,6 +14,13 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
@@ -22,15 +29,26 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/rpc"
 )
 
+const (
+	maxChangesPerPack             = 3
+	maxArrayLength                = 2
+	maxAttachedClientsPerDocument = 2
+)
+
 var (
 	nilClientID, _     = hex.DecodeString("000000000000000000000000")
 	emptyClientID, _   = hex.DecodeString("")
 	invalidClientID, _ = hex.DecodeString("invalid")
 
+	testMetrics   *prometheus.Metrics
 	testRPCServer *rpc.Server
 	testRPCAddr   = fmt.Sprintf("localhost:%d", helper.RPCPort)
 	testClient    api.YorkieClient
 
+	// limitsRPCPort is the port of the server with the limits, which is
+	// separated from the shared server not to affect the other tests.
+	limitsRPCPort = helper.RPCPort + 10
+
 	invalidChangePack = &api.ChangePack{
 		DocumentKey: &api.DocumentKey{
 			Collection: "invalid", Document: "invalid",
@@ -44,35 +62,22 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	testMetrics = met
 
-	be, err := backend.New(&backend.Config{
-		SnapshotThreshold: helper.SnapshotThreshold,
-	}, &mongo.Config{
-		ConnectionURI:     helper.MongoConnectionURI,
-		YorkieDatabase:    helper.TestDBName(),
-		ConnectionTimeout: helper.MongoConnectionTimeout,
-		PingTimeout:       helper.MongoPingTimeout,
-	}, &etcd.Config{
+	be, err := newTestBackend(&backend.Config{}, &etcd.Config{
 		Endpoints:     helper.ETCDEndpoints,
 		DialTimeout:   helper.ETCDDialTimeout.String(),
 		LockLeaseTime: helper.ETCDLockLeaseTime.String(),
-	}, testRPCAddr, met)
+	}, testRPCAddr)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	testRPCServer, err = rpc.NewServer(&rpc.Config{
-		Port:            helper.RPCPort,
-		MaxRequestBytes: helper.RPCMaxRequestBytes,
-	}, be)
+	testRPCServer, err = startTestServer(helper.RPCPort, be)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err := testRPCServer.Start(); err != nil {
-		log.Fatalf("failed rpc listen: %s\n", err)
-	}
-
 	conn, err := grpc.Dial(testRPCAddr, grpc.WithInsecure())
 	if err != nil {
 		log.Fatal(err)
@@ -88,6 +93,45 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+// newTestBackend creates a backend with the given config. The fields of the
+// config required by the backend are filled with the test values.
+func newTestBackend(
+	conf *backend.Config,
+	etcdConf *etcd.Config,
+	rpcAddr string,
+) (*backend.Backend, error) {
+	conf.SnapshotThreshold = helper.SnapshotThreshold
+	conf.SnapshotCompression = helper.SnapshotCompression
+	conf.DocCacheSize = helper.DocCacheSize
+	conf.AuthWebhookCacheSize = helper.AuthWebhookCacheSize
+	conf.MaxBroadcastPayloadSize = helper.MaxBroadcastPayloadSize
+
+	return backend.New(conf, &mongo.Config{
+		ConnectionURI:     helper.MongoConnectionURI,
+		YorkieDatabase:    helper.TestDBName(),
+		ConnectionTimeout: helper.MongoConnectionTimeout,
+		PingTimeout:       helper.MongoPingTimeout,
+	}, etcdConf, rpcAddr, testMetrics)
+}
+
+// startTestServer starts an RPC server of the given port with the given
+// backend.
+func startTestServer(port int, be *backend.Backend) (*rpc.Server, error) {
+	server, err := rpc.NewServer(&rpc.Config{
+		Port:            port,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+	}, be)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.Start(); err != nil {
+		return nil, fmt.Errorf("failed rpc listen: %w", err)
+	}
+
+	return server, nil
+}
+
 func TestRPCServerBackend(t *testing.T) {
 	t.Run("activate/deactivate client test", func(t *testing.T) {
 		activateResp, err := testClient.ActivateClient(
@@ -359,6 +403,276 @@ func TestRPCServerBackend(t *testing.T) {
 		)
 		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
 	})
+
+	t.Run("push/pull malformed changes test", func(t *testing.T) {
+		docKey := &key.Key{Collection: t.Name(), Document: t.Name()}
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(activateResp.ClientId)
+		assert.NoError(t, err)
+
+		toPBPack := func(changes ...*change.Change) *api.ChangePack {
+			pbPack, err := converter.ToChangePack(change.NewPack(
+				docKey,
+				checkpoint.New(0, changes[len(changes)-1].ClientSeq()),
+				changes,
+				nil,
+			))
+			assert.NoError(t, err)
+			return pbPack
+		}
+		pushPull := func(changes ...*change.Change) error {
+			_, err := testClient.PushPull(
+				context.Background(),
+				&api.PushPullRequest{
+					ClientId:   activateResp.ClientId,
+					ChangePack: toPBPack(changes...),
+				},
+			)
+			return err
+		}
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId:   activateResp.ClientId,
+				ChangePack: toPBPack(change.New(change.NewID(1, 1, actorID), "", nil)),
+			},
+		)
+		assert.NoError(t, err)
+
+		// client seqs are not contiguous
+		err = pushPull(change.New(change.NewID(3, 2, actorID), "", nil))
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// lamports are not monotonic
+		err = pushPull(
+			change.New(change.NewID(2, 5, actorID), "", nil),
+			change.New(change.NewID(3, 4, actorID), "", nil),
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// lamport is not greater than the one of the last pushed change
+		err = pushPull(change.New(change.NewID(2, 1, actorID), "", nil))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// changes do not reach the checkpoint of the pack
+		pbPack := toPBPack(change.New(change.NewID(2, 2, actorID), "", nil))
+		pbPack.Checkpoint.ClientSeq = 3
+		_, err = testClient.PushPull(
+			context.Background(),
+			&api.PushPullRequest{ClientId: activateResp.ClientId, ChangePack: pbPack},
+		)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// changes are made by another actor
+		err = pushPull(change.New(change.NewID(2, 2, time.InitialActorID), "", nil))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// operations reference an element that does not exist
+		executedAt := time.NewTicket(2, 1, actorID)
+		err = pushPull(change.New(change.NewID(2, 2, actorID), "", []operation.Operation{
+			operation.NewSet(
+				time.NewTicket(100, 0, actorID),
+				"k",
+				json.NewPrimitive("v", executedAt),
+				executedAt,
+			),
+		}))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// operations edit a text at a position that does not exist
+		textCreatedAt := time.NewTicket(2, 1, actorID)
+		bogusPos := json.NewRGATreeSplitNodePos(
+			json.NewRGATreeSplitNodeID(time.NewTicket(100, 0, actorID), 0),
+			0,
+		)
+		err = pushPull(change.New(change.NewID(2, 2, actorID), "", []operation.Operation{
+			operation.NewSet(
+				time.InitialTicket,
+				"text",
+				json.NewText(json.NewRGATreeSplit(json.InitialTextNode()), textCreatedAt),
+				textCreatedAt,
+			),
+			operation.NewEdit(
+				textCreatedAt,
+				bogusPos,
+				bogusPos,
+				nil,
+				"a",
+				time.NewTicket(2, 2, actorID),
+			),
+		}))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		assert.NoError(t, pushPull(change.New(change.NewID(2, 2, actorID), "", nil)))
+	})
+}
+
+func TestRPCServerLimits(t *testing.T) {
+	limitsRPCAddr := fmt.Sprintf("localhost:%d", limitsRPCPort)
+	be, err := newTestBackend(&backend.Config{
+		MaxChangesPerPack:             maxChangesPerPack,
+		MaxArrayLength:                maxArrayLength,
+		MaxAttachedClientsPerDocument: maxAttachedClientsPerDocument,
+	}, nil, limitsRPCAddr)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, be.Close()) }()
+
+	server, err := startTestServer(limitsRPCPort, be)
+	assert.NoError(t, err)
+	defer server.Shutdown(true)
+
+	conn, err := grpc.Dial(limitsRPCAddr, grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, conn.Close()) }()
+	limitsClient := api.NewYorkieClient(conn)
+
+	docKey := &key.Key{Collection: t.Name(), Document: t.Name()}
+
+	var clientIDs [][]byte
+	var actorIDs []*time.ActorID
+	for i := 0; i <= maxAttachedClientsPerDocument; i++ {
+		activateResp, err := limitsClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: fmt.Sprintf("%s-%d", t.Name(), i)},
+		)
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(activateResp.ClientId)
+		assert.NoError(t, err)
+
+		clientIDs = append(clientIDs, activateResp.ClientId)
+		actorIDs = append(actorIDs, actorID)
+	}
+
+	toPBPack := func(changes ...*change.Change) *api.ChangePack {
+		pbPack, err := converter.ToChangePack(change.NewPack(
+			docKey,
+			checkpoint.New(0, changes[len(changes)-1].ClientSeq()),
+			changes,
+			nil,
+		))
+		assert.NoError(t, err)
+		return pbPack
+	}
+
+	// attach the document until the max attached clients
+	for i, clientID := range clientIDs {
+		_, err := limitsClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId:   clientID,
+				ChangePack: toPBPack(change.New(change.NewID(1, 1, actorIDs[i]), "", nil)),
+			},
+		)
+		if i < maxAttachedClientsPerDocument {
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+		}
+	}
+
+	pushPull := func(changes ...*change.Change) error {
+		_, err := limitsClient.PushPull(
+			context.Background(),
+			&api.PushPullRequest{
+				ClientId:   clientIDs[0],
+				ChangePack: toPBPack(changes...),
+			},
+		)
+		return err
+	}
+
+	// too many changes in a pack
+	var changes []*change.Change
+	for i := 0; i <= maxChangesPerPack; i++ {
+		changes = append(changes, change.New(change.NewID(uint32(i+2), uint64(i+2), actorIDs[0]), "", nil))
+	}
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(pushPull(changes...)).Code())
+
+	// too many elements in an array
+	executedAt := time.NewTicket(2, 1, actorIDs[0])
+	arr := json.NewArray(json.NewRGATreeList(), executedAt)
+	for i := 0; i <= maxArrayLength; i++ {
+		arr.Add(json.NewPrimitive(i, time.NewTicket(2, uint32(i+2), actorIDs[0])))
+	}
+	err = pushPull(change.New(change.NewID(2, 2, actorIDs[0]), "", []operation.Operation{
+		operation.NewSet(time.InitialTicket, "k", arr, executedAt),
+	}))
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+	assert.NoError(t, pushPull(change.New(change.NewID(2, 2, actorIDs[0]), "", nil)))
+
+	// the document over the lowered limits can be changed without growing
+	executedAt = time.NewTicket(3, 1, actorIDs[0])
+	arr = json.NewArray(json.NewRGATreeList(), executedAt)
+	for i := 0; i < maxArrayLength; i++ {
+		arr.Add(json.NewPrimitive(i, time.NewTicket(3, uint32(i+2), actorIDs[0])))
+	}
+	assert.NoError(t, pushPull(change.New(change.NewID(3, 3, actorIDs[0]), "", []operation.Operation{
+		operation.NewSet(time.InitialTicket, "k", arr, executedAt),
+	})))
+
+	be.Config.MaxArrayLength = maxArrayLength - 1
+	defer func() { be.Config.MaxArrayLength = maxArrayLength }()
+
+	executedAt = time.NewTicket(4, 1, actorIDs[0])
+	assert.NoError(t, pushPull(change.New(change.NewID(4, 4, actorIDs[0]), "", []operation.Operation{
+		operation.NewSet(time.InitialTicket, "k2", json.NewPrimitive("v", executedAt), executedAt),
+	})))
+
+	executedAt = time.NewTicket(5, 1, actorIDs[0])
+	err = pushPull(change.New(change.NewID(5, 5, actorIDs[0]), "", []operation.Operation{
+		operation.NewAdd(
+			arr.CreatedAt(),
+			arr.Elements()[len(arr.Elements())-1].CreatedAt(),
+			json.NewPrimitive("v", executedAt),
+			executedAt,
+		),
+	}))
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+	// concurrent attachments do not exceed the max attached clients
+	concurrentDocKey := &key.Key{Collection: t.Name(), Document: t.Name() + "-concurrent"}
+	pbPack, err := converter.ToChangePack(change.NewPack(
+		concurrentDocKey,
+		checkpoint.Initial,
+		nil,
+		nil,
+	))
+	assert.NoError(t, err)
+
+	attachments := 2 * maxAttachedClientsPerDocument
+	errs := make(chan error, attachments)
+	for i := 0; i < attachments; i++ {
+		activateResp, err := limitsClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: fmt.Sprintf("%s-concurrent-%d", t.Name(), i)},
+		)
+		assert.NoError(t, err)
+
+		go func(clientID []byte) {
+			_, err := limitsClient.AttachDocument(
+				context.Background(),
+				&api.AttachDocumentRequest{ClientId: clientID, ChangePack: pbPack},
+			)
+			errs <- err
+		}(activateResp.ClientId)
+	}
+
+	attached := 0
+	for i := 0; i < attachments; i++ {
+		if err := <-errs; err == nil {
+			attached++
+		} else {
+			assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+		}
+	}
+	assert.Equal(t, maxAttachedClientsPerDocument, attached)
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -373,6 +687,9 @@ func TestConfig_Validate(t *testing.T) {
 		{config: &rpc.Config{Port: 11101, CertFile: "", KeyFile: ""}, expected: nil},
 		// pass any file existing
 		{config: &rpc.Config{Port: 11101, CertFile: "server_test.go", KeyFile: "server_test.go"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, ClientRateLimit: -1}, expected: rpc.ErrInvalidRateLimit},
+		{config: &rpc.Config{Port: 11101, DocumentRateLimit: 1}, expected: rpc.ErrInvalidRateLimit},
+		{config: &rpc.Config{Port: 11101, TokenRateLimit: 1, TokenRateBurst: 1}, expected: nil},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
@@ -18,19 +18,35 @@ package rpc
 
 import (
 	"context"
+	"fmt"
+	"io"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/internal/log"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/audit"
 	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/clients"
 	"github.com/yorkie-team/yorkie/yorkie/packs"
 )
 
+// Below are the settings of the stream of the stored changes.
+const (
+	// changeStreamBatchSize is the max number of changes read at once.
+	changeStreamBatchSize = 100
+
+	// changeStreamPollInterval is the interval of polling new changes after
+	// all stored changes are delivered.
+	changeStreamPollInterval = 500 * gotime.Millisecond
+)
+
 type yorkieServer struct {
 	backend    *backend.Backend
 	serviceCtx context.Context
@@ -53,7 +69,7 @@ func (s *yorkieServer) ActivateClient(
 		return nil, clients.ErrInvalidClientKey
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	if err := s.verifyAccess(ctx, "", &types.AccessInfo{
 		Method: types.ActivateClient,
 	}); err != nil {
 		return nil, err
@@ -79,7 +95,7 @@ func (s *yorkieServer) DeactivateClient(
 		return nil, clients.ErrInvalidClientID
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	if err := s.verifyAccess(ctx, db.IDFromBytes(req.ClientId), &types.AccessInfo{
 		Method: types.DeactivateClient,
 	}); err != nil {
 		return nil, err
@@ -105,14 +121,14 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
-		Method:     types.AttachDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	if err := s.verifyPackAccess(ctx, db.IDFromBytes(req.ClientId), types.AttachDocument, pack); err != nil {
 		return nil, err
 	}
 
-	if pack.HasChanges() {
+	// NOTE: The attached clients are counted and the client is attached under
+	//       the lock of the document, so that concurrent attachments do not
+	//       exceed the limit.
+	if pack.HasChanges() || s.backend.Config.MaxAttachedClientsPerDocument > 0 {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
 			packs.NewPushPullKey(pack.DocumentKey),
@@ -141,11 +157,33 @@ func (s *yorkieServer) AttachDocument(
 	if err != nil {
 		return nil, err
 	}
+	if err := packs.CheckAttachLimit(ctx, s.backend, clientInfo, docInfo); err != nil {
+		return nil, err
+	}
 	if err := clientInfo.AttachDocument(docInfo.ID); err != nil {
 		return nil, err
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
+	if err := audit.Record(
+		ctx,
+		s.backend,
+		types.AttachDocument,
+		clientInfo.ID,
+		[]*key.Key{pack.DocumentKey},
+		pack.OperationsLen(),
+	); err != nil {
+		return nil, err
+	}
+
+	pulled, err := packs.PushPull(
+		ctx,
+		s.backend,
+		clientInfo,
+		docInfo,
+		pack,
+		false,
+		req.SnapshotCompressions,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -170,10 +208,7 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
-		Method:     types.DetachDocument,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	if err := s.verifyPackAccess(ctx, db.IDFromBytes(req.ClientId), types.DetachDocument, pack); err != nil {
 		return nil, err
 	}
 
@@ -213,7 +248,26 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
+	if err := audit.Record(
+		ctx,
+		s.backend,
+		types.DetachDocument,
+		clientInfo.ID,
+		[]*key.Key{pack.DocumentKey},
+		pack.OperationsLen(),
+	); err != nil {
+		return nil, err
+	}
+
+	pulled, err := packs.PushPull(
+		ctx,
+		s.backend,
+		clientInfo,
+		docInfo,
+		pack,
+		false,
+		req.SnapshotCompressions,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -239,10 +293,7 @@ func (s *yorkieServer) PushPull(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
-		Method:     types.PushPull,
-		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	if err := s.verifyPackAccess(ctx, db.IDFromBytes(req.ClientId), types.PushPull, pack); err != nil {
 		return nil, err
 	}
 
@@ -280,7 +331,28 @@ func (s *yorkieServer) PushPull(
 		return nil, err
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
+	if pack.HasChanges() {
+		if err := audit.Record(
+			ctx,
+			s.backend,
+			types.PushPull,
+			clientInfo.ID,
+			[]*key.Key{pack.DocumentKey},
+			pack.OperationsLen(),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	pulled, err := packs.PushPull(
+		ctx,
+		s.backend,
+		clientInfo,
+		docInfo,
+		pack,
+		req.PushOnly,
+		req.SnapshotCompressions,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -306,21 +378,30 @@ func (s *yorkieServer) WatchDocuments(
 		return err
 	}
 	docKeys := converter.FromDocumentKeys(req.DocumentKeys)
+	includeChanges := req.IncludeChanges
 
-	var attrs []types.AccessAttribute
-	for _, k := range docKeys {
-		attrs = append(attrs, types.AccessAttribute{
-			Key:  k.BSONKey(),
-			Verb: types.Read,
-		})
-	}
-	if err := auth.VerifyAccess(stream.Context(), s.backend, &types.AccessInfo{
+	if err := s.verifyAccess(stream.Context(), db.IDFromBytes(client.ID.Bytes()), &types.AccessInfo{
 		Method:     types.WatchDocuments,
-		Attributes: attrs,
+		Attributes: readAttributes(docKeys),
 	}); err != nil {
 		return err
 	}
 
+	clientInfo, err := clients.FindClient(stream.Context(), s.backend, client.ID.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := audit.Record(
+		stream.Context(),
+		s.backend,
+		types.WatchDocuments,
+		clientInfo.ID,
+		docKeys,
+		0,
+	); err != nil {
+		return err
+	}
+
 	subscription, peersMap, err := s.watchDocs(
 		stream.Context(),
 		*client,
@@ -352,18 +433,15 @@ func (s *yorkieServer) WatchDocuments(
 			s.unwatchDocs(docKeys, subscription)
 			return nil
 		case event := <-subscription.Events():
-			eventType, err := converter.ToDocEventType(event.Type)
+			pbEvent, err := toPBDocEvent(event, includeChanges)
 			if err != nil {
+				s.unwatchDocs(docKeys, subscription)
 				return err
 			}
 
 			if err := stream.Send(&api.WatchDocumentsResponse{
 				Body: &api.WatchDocumentsResponse_Event{
-					Event: &api.DocEvent{
-						Type:         eventType,
-						Publisher:    converter.ToClient(event.Publisher),
-						DocumentKeys: converter.ToDocumentKeys(event.DocumentKeys),
-					},
+					Event: pbEvent,
 				},
 			}); err != nil {
 				log.Logger.Error(err)
@@ -385,6 +463,23 @@ func (s *yorkieServer) UpdateMetadata(
 	}
 	keys := converter.FromDocumentKeys(req.DocumentKeys)
 
+	if err := s.verifyAccess(ctx, db.IDFromBytes(client.ID.Bytes()), &types.AccessInfo{
+		Method:     types.UpdateMetadata,
+		Attributes: readAttributes(keys),
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.FindClient(ctx, s.backend, client.ID.Bytes()); err != nil {
+		return nil, err
+	}
+
+	// NOTE: The peers of a document are its watchers, so only the watchers
+	//       can change their metadata in the document.
+	if err := s.backend.Coordinator.EnsureSubscribed(ctx, client.ID, keys); err != nil {
+		return nil, err
+	}
+
 	docEvent, err := s.backend.Coordinator.UpdateMetadata(ctx, client, keys)
 	if err != nil {
 		return nil, err
@@ -395,6 +490,424 @@ func (s *yorkieServer) UpdateMetadata(
 	return &api.UpdateMetadataResponse{}, nil
 }
 
+// WatchDocumentsStream connects the stream to deliver events from the given
+// documents to the requesting client. Unlike WatchDocuments, the client can
+// add and remove documents on the live stream without reopening it. The first
+// request must have the documents to watch, and the stream ends when all the
+// documents are removed.
+func (s *yorkieServer) WatchDocumentsStream(
+	stream api.Yorkie_WatchDocumentsStreamServer,
+) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	client, err := converter.FromClient(req.Client)
+	if err != nil {
+		return err
+	}
+	docKeys := converter.FromDocumentKeys(req.AddedDocumentKeys)
+	includeChanges := req.IncludeChanges
+
+	if err := s.verifyAccess(stream.Context(), db.IDFromBytes(client.ID.Bytes()), &types.AccessInfo{
+		Method:     types.WatchDocuments,
+		Attributes: readAttributes(docKeys),
+	}); err != nil {
+		return err
+	}
+
+	clientInfo, err := clients.FindClient(stream.Context(), s.backend, client.ID.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := audit.Record(
+		stream.Context(),
+		s.backend,
+		types.WatchDocuments,
+		clientInfo.ID,
+		docKeys,
+		0,
+	); err != nil {
+		return err
+	}
+
+	subscription, peersMap, err := s.watchDocs(
+		stream.Context(),
+		*client,
+		docKeys,
+	)
+	if err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	watchedKeys := make(map[string]*key.Key)
+	for _, k := range docKeys {
+		watchedKeys[k.BSONKey()] = k
+	}
+	keysOf := func() []*key.Key {
+		var keys []*key.Key
+		for _, k := range watchedKeys {
+			keys = append(keys, k)
+		}
+		return keys
+	}
+
+	if err := stream.Send(&api.WatchDocumentsResponse{
+		Body: &api.WatchDocumentsResponse_Initialization_{
+			Initialization: &api.WatchDocumentsResponse_Initialization{
+				PeersMapByDoc: converter.ToClientsMap(peersMap),
+			},
+		},
+	}); err != nil {
+		log.Logger.Error(err)
+		s.unwatchDocs(keysOf(), subscription)
+		return err
+	}
+
+	reqCh := make(chan *api.WatchDocumentsStreamRequest)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			select {
+			case reqCh <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-s.serviceCtx.Done():
+			s.unwatchDocs(keysOf(), subscription)
+			return nil
+		case <-stream.Context().Done():
+			s.unwatchDocs(keysOf(), subscription)
+			return nil
+		case err := <-errCh:
+			if err == io.EOF {
+				// NOTE: The client closed sending, but keeps receiving events.
+				errCh = nil
+				continue
+			}
+			s.unwatchDocs(keysOf(), subscription)
+			return err
+		case req := <-reqCh:
+			requested := converter.FromDocumentKeys(req.AddedDocumentKeys)
+			if err := s.verifyAccess(stream.Context(), clientInfo.ID, &types.AccessInfo{
+				Method:     types.WatchDocuments,
+				Attributes: readAttributes(requested),
+			}); err != nil {
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+
+			// NOTE: Only the documents whose subscription actually changes are
+			//       updated, so that the peers are not notified of the
+			//       documents already watched or never watched.
+			added, removed := updateWatchedKeys(
+				watchedKeys,
+				requested,
+				converter.FromDocumentKeys(req.RemovedDocumentKeys),
+			)
+			if len(watchedKeys) == 0 {
+				s.unwatchDocs(removed, subscription)
+				return nil
+			}
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+			if err := audit.Record(
+				stream.Context(),
+				s.backend,
+				types.WatchDocuments,
+				clientInfo.ID,
+				added,
+				0,
+			); err != nil {
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+
+			peersMap, err := s.updateWatchedDocs(
+				stream.Context(),
+				subscription,
+				added,
+				removed,
+			)
+			if err != nil {
+				log.Logger.Error(err)
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+
+			if len(added) == 0 {
+				continue
+			}
+
+			if err := stream.Send(&api.WatchDocumentsResponse{
+				Body: &api.WatchDocumentsResponse_Initialization_{
+					Initialization: &api.WatchDocumentsResponse_Initialization{
+						PeersMapByDoc: converter.ToClientsMap(peersMap),
+					},
+				},
+			}); err != nil {
+				log.Logger.Error(err)
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+		case event := <-subscription.Events():
+			pbEvent, err := toPBDocEvent(event, includeChanges)
+			if err != nil {
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+
+			if err := stream.Send(&api.WatchDocumentsResponse{
+				Body: &api.WatchDocumentsResponse_Event{
+					Event: pbEvent,
+				},
+			}); err != nil {
+				log.Logger.Error(err)
+				s.unwatchDocs(keysOf(), subscription)
+				return err
+			}
+		}
+	}
+}
+
+// Broadcast publishes the given transient message to the peers watching the
+// given documents. The message is not stored as a change.
+func (s *yorkieServer) Broadcast(
+	ctx context.Context,
+	req *api.BroadcastRequest,
+) (*api.BroadcastResponse, error) {
+	client, err := converter.FromClient(req.Client)
+	if err != nil {
+		return nil, err
+	}
+	docKeys := converter.FromDocumentKeys(req.DocumentKeys)
+
+	if uint64(len(req.Payload)) > s.backend.Config.MaxBroadcastPayloadSize {
+		return nil, fmt.Errorf(
+			"%d bytes exceeds %d bytes: %w",
+			len(req.Payload),
+			s.backend.Config.MaxBroadcastPayloadSize,
+			sync.ErrPayloadTooLarge,
+		)
+	}
+
+	if err := s.verifyAccess(ctx, db.IDFromBytes(client.ID.Bytes()), &types.AccessInfo{
+		Method:     types.Broadcast,
+		Attributes: readAttributes(docKeys),
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.FindClient(ctx, s.backend, client.ID.Bytes()); err != nil {
+		return nil, err
+	}
+
+	if err := s.backend.Coordinator.EnsureSubscribed(ctx, client.ID, docKeys); err != nil {
+		return nil, err
+	}
+
+	s.backend.Coordinator.Publish(ctx, client.ID, sync.DocEvent{
+		Type:         types.BroadcastEvent,
+		Publisher:    *client,
+		DocumentKeys: docKeys,
+		Payload:      req.Payload,
+	})
+
+	return &api.BroadcastResponse{}, nil
+}
+
+// FetchDocument returns the snapshot of the given document without activating
+// a client or attaching the document. The caller does not appear as a peer
+// and does not affect garbage collection of the document.
+func (s *yorkieServer) FetchDocument(
+	ctx context.Context,
+	req *api.FetchDocumentRequest,
+) (*api.FetchDocumentResponse, error) {
+	docKey, err := converter.FromDocumentKey(req.DocumentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyAccess(ctx, "", &types.AccessInfo{
+		Method: types.FetchDocument,
+		Attributes: []types.AccessAttribute{{
+			Key:  docKey.BSONKey(),
+			Verb: types.Read,
+		}},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := audit.Record(
+		ctx,
+		s.backend,
+		types.FetchDocument,
+		"",
+		[]*key.Key{docKey},
+		0,
+	); err != nil {
+		return nil, err
+	}
+
+	docInfo, err := s.backend.DB.FindDocInfoByKeyReadOnly(ctx, docKey.BSONKey())
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, err := packs.FetchSnapshot(
+		ctx,
+		s.backend,
+		docInfo,
+		req.ServerSeq,
+		req.SnapshotCompressions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChangePack, err := fetched.ToPBChangePack()
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.FetchDocumentResponse{
+		ChangePack: pbChangePack,
+	}, nil
+}
+
+// WatchChanges streams the changes of the given collection stored after the
+// given cursor, or from around the given start time. The changes are delivered
+// at least once in the order of their collection seqs, and each of them
+// carries the cursor to resume the stream after it.
+func (s *yorkieServer) WatchChanges(
+	req *api.WatchChangesRequest,
+	stream api.Yorkie_WatchChangesServer,
+) error {
+	if req.Collection == "" {
+		return converter.ErrCollectionRequired
+	}
+
+	// NOTE: The changes of all the documents of the collection are read, so
+	//       the access is verified and recorded as on "collection$*".
+	collectionKey := &key.Key{Collection: req.Collection, Document: "*"}
+	if err := s.verifyAccess(stream.Context(), "", &types.AccessInfo{
+		Method: types.WatchChanges,
+		Attributes: []types.AccessAttribute{{
+			Key:  collectionKey.BSONKey(),
+			Verb: types.Read,
+		}},
+	}); err != nil {
+		return err
+	}
+	if err := audit.Record(
+		stream.Context(),
+		s.backend,
+		types.WatchChanges,
+		"",
+		[]*key.Key{collectionKey},
+		0,
+	); err != nil {
+		return err
+	}
+
+	var cursor db.ChangeCursor
+	if req.Cursor != "" {
+		parsed, err := db.ParseChangeCursor(req.Cursor)
+		if err != nil {
+			return err
+		}
+		cursor = parsed
+	} else if req.StartTime > 0 {
+		found, err := packs.FindChangeCursorByTime(
+			stream.Context(),
+			s.backend,
+			req.Collection,
+			gotime.UnixMilli(req.StartTime),
+		)
+		if err != nil {
+			return err
+		}
+		cursor = found
+	}
+
+	for {
+		infos, err := s.backend.DB.FindChangeInfosAfterCursor(
+			stream.Context(),
+			req.Collection,
+			cursor,
+			changeStreamBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range infos {
+			resp, err := toWatchChangesResponse(info)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(resp); err != nil {
+				log.Logger.Error(err)
+				return err
+			}
+			cursor = info.Cursor()
+		}
+
+		if len(infos) == changeStreamBatchSize {
+			continue
+		}
+
+		select {
+		case <-s.serviceCtx.Done():
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case <-gotime.After(changeStreamPollInterval):
+		}
+	}
+}
+
+// toWatchChangesResponse converts the given stored change to the response of
+// WatchChanges.
+func toWatchChangesResponse(info *db.ChangeInfo) (*api.WatchChangesResponse, error) {
+	docKey, err := key.FromBSONKey(info.DocKey)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := info.ToChange()
+	if err != nil {
+		return nil, err
+	}
+
+	pbChange, err := converter.ToChange(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.WatchChangesResponse{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		ServerSeq:   info.ServerSeq,
+		Change:      pbChange,
+		Cursor:      info.Cursor().String(),
+	}, nil
+}
+
 func (s *yorkieServer) watchDocs(
 	ctx context.Context,
 	client types.Client,
@@ -423,6 +936,89 @@ func (s *yorkieServer) watchDocs(
 	return subscription, peersMap, nil
 }
 
+func (s *yorkieServer) updateWatchedDocs(
+	ctx context.Context,
+	subscription *sync.Subscription,
+	added []*key.Key,
+	removed []*key.Key,
+) (map[string][]types.Client, error) {
+	peersMap, err := s.backend.Coordinator.UpdateSubscription(
+		ctx,
+		subscription,
+		added,
+		removed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(added) > 0 {
+		s.backend.Coordinator.Publish(
+			ctx,
+			subscription.Subscriber().ID,
+			sync.DocEvent{
+				Type:         types.DocumentsWatchedEvent,
+				Publisher:    subscription.Subscriber(),
+				DocumentKeys: added,
+			},
+		)
+	}
+	if len(removed) > 0 {
+		s.backend.Coordinator.Publish(
+			ctx,
+			subscription.Subscriber().ID,
+			sync.DocEvent{
+				Type:         types.DocumentsUnwatchedEvent,
+				Publisher:    subscription.Subscriber(),
+				DocumentKeys: removed,
+			},
+		)
+	}
+
+	return peersMap, nil
+}
+
+// updateWatchedKeys adds and removes the given keys to and from the given
+// watched keys, and returns the keys actually added and removed. A key added
+// and removed at once is neither added nor removed if it was not watched.
+func updateWatchedKeys(
+	watchedKeys map[string]*key.Key,
+	added []*key.Key,
+	removed []*key.Key,
+) ([]*key.Key, []*key.Key) {
+	newKeys := make(map[string]*key.Key)
+	for _, k := range added {
+		if _, ok := watchedKeys[k.BSONKey()]; ok {
+			continue
+		}
+		watchedKeys[k.BSONKey()] = k
+		newKeys[k.BSONKey()] = k
+	}
+
+	var removedKeys []*key.Key
+	for _, k := range removed {
+		if _, ok := watchedKeys[k.BSONKey()]; !ok {
+			continue
+		}
+		delete(watchedKeys, k.BSONKey())
+		if _, ok := newKeys[k.BSONKey()]; ok {
+			delete(newKeys, k.BSONKey())
+			continue
+		}
+		removedKeys = append(removedKeys, k)
+	}
+
+	var addedKeys []*key.Key
+	for _, k := range added {
+		if _, ok := newKeys[k.BSONKey()]; ok {
+			addedKeys = append(addedKeys, k)
+			delete(newKeys, k.BSONKey())
+		}
+	}
+
+	return addedKeys, removedKeys
+}
+
 func (s *yorkieServer) unwatchDocs(
 	docKeys []*key.Key,
 	subscription *sync.Subscription,
@@ -439,3 +1035,61 @@ func (s *yorkieServer) unwatchDocs(
 		},
 	)
 }
+
+// verifyAccess verifies the access of the given client with the given info.
+// The denied access is recorded in the audit log.
+func (s *yorkieServer) verifyAccess(
+	ctx context.Context,
+	clientID db.ID,
+	info *types.AccessInfo,
+) error {
+	if err := auth.VerifyAccess(ctx, s.backend, info); err != nil {
+		if auth.IsDenied(err) {
+			audit.RecordDenied(ctx, s.backend, info.Method, clientID, info.Attributes)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// verifyPackAccess verifies the access of the given client with the given
+// method to the document of the given pack. The denied access is recorded in
+// the audit log.
+func (s *yorkieServer) verifyPackAccess(
+	ctx context.Context,
+	clientID db.ID,
+	method types.Method,
+	pack *change.Pack,
+) error {
+	if err := auth.VerifyPackAccess(ctx, s.backend, method, pack); err != nil {
+		if auth.IsDenied(err) {
+			audit.RecordDenied(ctx, s.backend, method, clientID, auth.AccessAttributes(pack))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// readAttributes returns the access attributes to read the given documents.
+func readAttributes(docKeys []*key.Key) []types.AccessAttribute {
+	var attrs []types.AccessAttribute
+	for _, k := range docKeys {
+		attrs = append(attrs, types.AccessAttribute{
+			Key:  k.BSONKey(),
+			Verb: types.Read,
+		})
+	}
+	return attrs
+}
+
+// toPBDocEvent converts the given event to Protobuf format. The changes of the
+// event are excluded unless the subscriber asked for them.
+func toPBDocEvent(event sync.DocEvent, includeChanges bool) (*api.DocEvent, error) {
+	if !includeChanges {
+		event.ChangePack = nil
+	}
+
+	return converter.ToDocEvent(event)
+}
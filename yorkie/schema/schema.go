@@ -0,0 +1,345 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schema provides JSON-Schema-like schemas that describe the documents
+// of a collection, and validates documents against them.
+package schema
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// Below are the types of the elements that a schema can describe.
+const (
+	TypeObject   = "object"
+	TypeArray    = "array"
+	TypeNull     = "null"
+	TypeBoolean  = "boolean"
+	TypeInteger  = "integer"
+	TypeLong     = "long"
+	TypeDouble   = "double"
+	TypeString   = "string"
+	TypeBytes    = "bytes"
+	TypeDate     = "date"
+	TypeCounter  = "counter"
+	TypeText     = "text"
+	TypeRichText = "rich_text"
+)
+
+var (
+	// ErrSchemaViolation is returned when the document violates the schema.
+	ErrSchemaViolation = errors.New("schema violation")
+
+	// ErrInvalidSchema is returned when the given schema is not valid.
+	ErrInvalidSchema = errors.New("invalid schema")
+)
+
+// Schema describes an element of a document. The root schema of a collection
+// describes the root object of its documents.
+type Schema struct {
+	// Type is the type of the element. If it is empty, any type is allowed.
+	Type string `json:"type"`
+
+	// Properties are the schemas of the members of an object.
+	Properties map[string]*Schema `json:"properties"`
+
+	// Required is the keys of the members that an object must have.
+	Required []string `json:"required"`
+
+	// AdditionalProperties is whether an object can have members that are not
+	// described in Properties. If it is nil, they are allowed.
+	AdditionalProperties *bool `json:"additionalProperties"`
+
+	// Items is the schema of the elements of an array.
+	Items *Schema `json:"items"`
+
+	// MaxItems is the maximum number of the elements of an array. Zero means
+	// no limit.
+	MaxItems int `json:"maxItems"`
+
+	// MaxLength is the maximum length of a string. Zero means no limit.
+	MaxLength int `json:"maxLength"`
+}
+
+// Registry is a map of the root schemas by collection.
+type Registry map[string]*Schema
+
+// NewRegistryFromFile creates a Registry from the given JSON file that maps
+// collections to their schemas.
+func NewRegistryFromFile(path string) (Registry, error) {
+	file, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	registry := Registry{}
+	if err := gojson.Unmarshal(file, &registry); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", path, err.Error(), ErrInvalidSchema)
+	}
+
+	for collection, s := range registry {
+		if err := s.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", collection, err)
+		}
+	}
+
+	return registry, nil
+}
+
+// Validate validates the root object of the document of the given collection.
+// Collections without a schema are not validated.
+func (r Registry) Validate(collection string, root *json.Object) error {
+	s, ok := r[collection]
+	if !ok {
+		return nil
+	}
+
+	return s.Validate(root)
+}
+
+// Violations returns the violations of the root object of the document of the
+// given collection. Collections without a schema have no violations.
+func (r Registry) Violations(collection string, root *json.Object) Violations {
+	s, ok := r[collection]
+	if !ok {
+		return nil
+	}
+
+	return s.Violations(root)
+}
+
+// ValidateChange validates the root object of the document of the given
+// collection after changes against the violations before them. Only the
+// violations that the changes make are returned, so that the documents that
+// violated the schema before it was registered can still be changed.
+func (r Registry) ValidateChange(collection string, before Violations, root *json.Object) error {
+	after := r.Violations(collection, root)
+	for _, key := range after.keys() {
+		v := after[key]
+		if prev, ok := before[key]; ok && v.size <= prev.size {
+			continue
+		}
+
+		return v.Err
+	}
+
+	return nil
+}
+
+// Violation is a violation of an element of a document against its schema.
+type Violation struct {
+	// Err describes the violation. It wraps ErrSchemaViolation.
+	Err error
+
+	// size is the size of the element for the violations of the limits, such
+	// as the number of the items of an array. A violation that gets larger is
+	// treated as a new one.
+	size int
+}
+
+// Violations is the violations of a document by the elements that violate
+// the schema and the kinds of the violations. The elements are identified by
+// their creation tickets, so that the violations are tracked across changes
+// that move them.
+type Violations map[string]*Violation
+
+// add adds a violation of the given kind by the given element.
+func (v Violations) add(elem json.Element, kind string, size int, err error) {
+	v[elem.CreatedAt().Key()+":"+kind] = &Violation{Err: err, size: size}
+}
+
+// keys returns the keys of the violations in order.
+func (v Violations) keys() []string {
+	var keys []string
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Validate validates the given element against this schema.
+func (s *Schema) Validate(elem json.Element) error {
+	violations := s.Violations(elem)
+	keys := violations.keys()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return violations[keys[0]].Err
+}
+
+// Violations returns all the violations of the given element against this
+// schema.
+func (s *Schema) Violations(elem json.Element) Violations {
+	violations := Violations{}
+	s.validateElement("$", elem, violations)
+	return violations
+}
+
+func (s *Schema) validate() error {
+	if s == nil {
+		return fmt.Errorf("null schema: %w", ErrInvalidSchema)
+	}
+
+	switch s.Type {
+	case "", TypeObject, TypeArray, TypeNull, TypeBoolean, TypeInteger,
+		TypeLong, TypeDouble, TypeString, TypeBytes, TypeDate, TypeCounter,
+		TypeText, TypeRichText:
+	default:
+		return fmt.Errorf("unknown type %q: %w", s.Type, ErrInvalidSchema)
+	}
+
+	for k, property := range s.Properties {
+		if err := property.validate(); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+	}
+	if s.Items != nil {
+		return s.Items.validate()
+	}
+
+	return nil
+}
+
+func (s *Schema) validateElement(path string, elem json.Element, violations Violations) {
+	if s.Type != "" && typeOf(elem) != s.Type {
+		violations.add(elem, "type", 0, fmt.Errorf(
+			"%s: expected %s, given %s: %w",
+			path,
+			s.Type,
+			typeOf(elem),
+			ErrSchemaViolation,
+		))
+		return
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		s.validateObject(path, elem, violations)
+	case *json.Array:
+		s.validateArray(path, elem, violations)
+	case *json.Primitive:
+		if s.MaxLength > 0 && elem.ValueType() == json.String {
+			if length := utf8.RuneCountInString(elem.Value().(string)); length > s.MaxLength {
+				violations.add(elem, "maxLength", length, fmt.Errorf(
+					"%s: longer than %d: %w",
+					path,
+					s.MaxLength,
+					ErrSchemaViolation,
+				))
+			}
+		}
+	}
+}
+
+func (s *Schema) validateObject(path string, obj *json.Object, violations Violations) {
+	members := obj.Members()
+
+	for _, k := range s.Required {
+		if _, ok := members[k]; !ok {
+			violations.add(obj, "required."+k, 0, fmt.Errorf(
+				"%s: missing required key %q: %w",
+				path,
+				k,
+				ErrSchemaViolation,
+			))
+		}
+	}
+
+	for k, member := range members {
+		property, ok := s.Properties[k]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				violations.add(member, "additionalProperties", 0, fmt.Errorf(
+					"%s: unexpected key %q: %w",
+					path,
+					k,
+					ErrSchemaViolation,
+				))
+			}
+			continue
+		}
+
+		property.validateElement(path+"."+k, member, violations)
+	}
+}
+
+func (s *Schema) validateArray(path string, arr *json.Array, violations Violations) {
+	elems := arr.Elements()
+	if s.MaxItems > 0 && len(elems) > s.MaxItems {
+		violations.add(arr, "maxItems", len(elems), fmt.Errorf(
+			"%s: more than %d items: %w",
+			path,
+			s.MaxItems,
+			ErrSchemaViolation,
+		))
+	}
+
+	if s.Items == nil {
+		return
+	}
+
+	for i, elem := range elems {
+		s.Items.validateElement(fmt.Sprintf("%s[%d]", path, i), elem, violations)
+	}
+}
+
+// typeOf returns the schema type of the given element.
+func typeOf(elem json.Element) string {
+	switch elem := elem.(type) {
+	case *json.Object:
+		return TypeObject
+	case *json.Array:
+		return TypeArray
+	case *json.Counter:
+		return TypeCounter
+	case *json.Text:
+		return TypeText
+	case *json.RichText:
+		return TypeRichText
+	case *json.Primitive:
+		switch elem.ValueType() {
+		case json.Null:
+			return TypeNull
+		case json.Boolean:
+			return TypeBoolean
+		case json.Integer:
+			return TypeInteger
+		case json.Long:
+			return TypeLong
+		case json.Double:
+			return TypeDouble
+		case json.String:
+			return TypeString
+		case json.Bytes:
+			return TypeBytes
+		case json.Date:
+			return TypeDate
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/yorkie/schema"
+)
+
+const todoSchema = `{
+  "todos": {
+    "type": "object",
+    "required": ["title", "items"],
+    "properties": {
+      "title": {"type": "string", "maxLength": 5},
+      "items": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+      "done": {"type": "counter"}
+    }
+  }
+}`
+
+func newRegistry(t *testing.T, content string) (schema.Registry, error) {
+	dir, err := ioutil.TempDir("", "schema")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	})
+
+	path := filepath.Join(dir, "schema.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	return schema.NewRegistryFromFile(path)
+}
+
+func TestSchema(t *testing.T) {
+	t.Run("load registry test", func(t *testing.T) {
+		_, err := newRegistry(t, todoSchema)
+		assert.NoError(t, err)
+
+		_, err = newRegistry(t, `{"todos": {"type": "unknown"}}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = newRegistry(t, `{"todos": [`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = newRegistry(t, `{"todos": null}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = newRegistry(t, `{"todos": {"properties": {"title": null}}}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = newRegistry(t, `{"todos": {"type": "array", "items": null}}`)
+		assert.NoError(t, err)
+	})
+
+	t.Run("validate test", func(t *testing.T) {
+		registry, err := newRegistry(t, todoSchema)
+		assert.NoError(t, err)
+
+		// 01. Valid document
+		doc := document.New("todos", "d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "work")
+			root.SetNewArray("items").AddString("a", "b")
+			root.SetNewCounter("done", 0)
+			root.SetBool("extra", true)
+			return nil
+		}))
+		assert.NoError(t, registry.Validate("todos", doc.RootObject()))
+
+		// 02. Collections without a schema are not validated
+		assert.NoError(t, registry.Validate("notes", document.New("notes", "d1").RootObject()))
+
+		// 03. Missing required key
+		doc = document.New("todos", "d2")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "work")
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+
+		// 04. Wrong type
+		doc = document.New("todos", "d3")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("title", 1)
+			root.SetNewArray("items")
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+
+		// 05. Wrong type of an array element
+		doc = document.New("todos", "d4")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "work")
+			root.SetNewArray("items").AddInteger(1)
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+
+		// 06. Array over the max items
+		doc = document.New("todos", "d5")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "work")
+			root.SetNewArray("items").AddString("a", "b", "c")
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+
+		// 07. String over the max length
+		doc = document.New("todos", "d6")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "homework")
+			root.SetNewArray("items")
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+	})
+
+	t.Run("additional properties test", func(t *testing.T) {
+		registry, err := newRegistry(t, `{
+		  "todos": {"properties": {"title": {}}, "additionalProperties": false}
+		}`)
+		assert.NoError(t, err)
+
+		doc := document.New("todos", "d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("title")
+			return nil
+		}))
+		assert.NoError(t, registry.Validate("todos", doc.RootObject()))
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNull("extra")
+			return nil
+		}))
+		assert.ErrorIs(t, registry.Validate("todos", doc.RootObject()), schema.ErrSchemaViolation)
+	})
+
+	t.Run("validate change test", func(t *testing.T) {
+		registry, err := newRegistry(t, todoSchema)
+		assert.NoError(t, err)
+
+		// 01. A document that violated the schema before it was registered
+		doc := document.New("todos", "d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "homework")
+			root.SetNewArray("items").AddString("a", "b", "c")
+			return nil
+		}))
+		before := registry.Violations("todos", doc.RootObject())
+		assert.Len(t, before, 2)
+
+		// 02. Changes that do not make new violations are allowed
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("done", 0)
+			root.GetArray("items").Delete(0)
+			return nil
+		}))
+		assert.NoError(t, registry.ValidateChange("todos", before, doc.RootObject()))
+
+		// 03. Changes that make new violations are rejected
+		before = registry.Violations("todos", doc.RootObject())
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("done", 1)
+			return nil
+		}))
+		assert.ErrorIs(
+			t,
+			registry.ValidateChange("todos", before, doc.RootObject()),
+			schema.ErrSchemaViolation,
+		)
+
+		// 04. Changes that make the violations larger are rejected
+		doc = document.New("todos", "d2")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("title", "work")
+			root.SetNewArray("items").AddString("a", "b", "c")
+			return nil
+		}))
+		before = registry.Violations("todos", doc.RootObject())
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("items").AddString("d")
+			return nil
+		}))
+		assert.ErrorIs(
+			t,
+			registry.ValidateChange("todos", before, doc.RootObject()),
+			schema.ErrSchemaViolation,
+		)
+	})
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"time"
+)
+
+// ErrInvalidCertificate is returned when the given certificates to call the
+// webhook are not valid.
+var ErrInvalidCertificate = errors.New("invalid certificate")
+
+// ClientOptions are the options of the HTTP client to call the webhook.
+type ClientOptions struct {
+	// CAFile is the path of the PEM file of the CA certificates to verify the
+	// webhook server. If it is empty, the system pool is used.
+	CAFile string
+
+	// CertFile and KeyFile are the paths of the PEM files of the certificate
+	// and the key of the client, presented when the webhook server asks.
+	CertFile string
+	KeyFile  string
+
+	// Timeout is the time limit of a request to the webhook. Zero means no
+	// limit.
+	Timeout time.Duration
+}
+
+// NewClient creates a new HTTP client to call the webhook with the given
+// options.
+func NewClient(opts ClientOptions) (*http.Client, error) {
+	if opts.CAFile == "" && opts.CertFile == "" && opts.KeyFile == "" {
+		return &http.Client{Timeout: opts.Timeout}, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.CAFile != "" {
+		caCerts, err := ioutil.ReadFile(filepath.Clean(opts.CAFile))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidCertificate)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("%s: no certificate: %w", opts.CAFile, ErrInvalidCertificate)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidCertificate)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
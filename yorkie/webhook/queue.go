@@ -0,0 +1,64 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrQueueFull is returned when a delivery is enqueued to the full queue.
+var ErrQueueFull = errors.New("webhook queue is full")
+
+// Queue runs the deliveries to a webhook one by one in the enqueued order.
+// Its buffer is bounded, so the deliveries are rejected instead of piling up
+// while the webhook is slower than the deliveries are enqueued.
+type Queue struct {
+	deliveries chan func(ctx context.Context)
+}
+
+// NewQueue creates a new instance of Queue that buffers up to the given size
+// of deliveries. The deliveries are not run until Run is called.
+func NewQueue(size int) *Queue {
+	return &Queue{
+		deliveries: make(chan func(ctx context.Context), size),
+	}
+}
+
+// Enqueue adds the given delivery to the end of the queue. It does not block
+// and returns ErrQueueFull if the buffer of the queue is full.
+func (q *Queue) Enqueue(delivery func(ctx context.Context)) error {
+	select {
+	case q.deliveries <- delivery:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Run runs the enqueued deliveries with the given context until it is done.
+// It should be called by only one goroutine to keep the order.
+func (q *Queue) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery := <-q.deliveries:
+			delivery(ctx)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2021 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Below are the headers of the signed webhook requests.
+const (
+	// TimestampHeader is the header of the unix time in seconds when the
+	// request is signed.
+	TimestampHeader = "X-Yorkie-Timestamp"
+
+	// SignatureHeader is the header of the signature of the request.
+	SignatureHeader = "X-Yorkie-Signature"
+)
+
+// signaturePrefix is the prefix of the signature that tells its algorithm.
+const signaturePrefix = "sha256="
+
+// Sign returns the signature of the given body at the given timestamp. The
+// signature is the hex-encoded HMAC-SHA256 of the timestamp, a dot and the
+// body, prefixed with "sha256=".
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest sets the timestamp and the signature headers of the given
+// request with the given body.
+func SignRequest(req *http.Request, secret []byte, body []byte, now time.Time) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+}
+
+// VerifySignature returns whether the given signature is the signature of the
+// given body at the given timestamp. Webhook servers should also reject the
+// requests with old timestamps to prevent replays.
+func VerifySignature(secret []byte, timestamp, signature string, body []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}